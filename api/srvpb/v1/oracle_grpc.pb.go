@@ -25,10 +25,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SandboxService_GetHealthCheck_FullMethodName = "/srvpb.v1.SandboxService/GetHealthCheck"
-	SandboxService_CreateClaim_FullMethodName    = "/srvpb.v1.SandboxService/CreateClaim"
-	SandboxService_AddClaimant_FullMethodName    = "/srvpb.v1.SandboxService/AddClaimant"
-	SandboxService_GetClaim_FullMethodName       = "/srvpb.v1.SandboxService/GetClaim"
+	SandboxService_GetHealthCheck_FullMethodName  = "/srvpb.v1.SandboxService/GetHealthCheck"
+	SandboxService_GetLiveness_FullMethodName     = "/srvpb.v1.SandboxService/GetLiveness"
+	SandboxService_GetReadiness_FullMethodName    = "/srvpb.v1.SandboxService/GetReadiness"
+	SandboxService_CreateClaim_FullMethodName     = "/srvpb.v1.SandboxService/CreateClaim"
+	SandboxService_AddClaimant_FullMethodName     = "/srvpb.v1.SandboxService/AddClaimant"
+	SandboxService_AdvanceClaim_FullMethodName    = "/srvpb.v1.SandboxService/AdvanceClaim"
+	SandboxService_SetClaimStatus_FullMethodName  = "/srvpb.v1.SandboxService/SetClaimStatus"
+	SandboxService_DeclineClaim_FullMethodName    = "/srvpb.v1.SandboxService/DeclineClaim"
+	SandboxService_ListClaims_FullMethodName      = "/srvpb.v1.SandboxService/ListClaims"
+	SandboxService_ExportClaims_FullMethodName    = "/srvpb.v1.SandboxService/ExportClaims"
+	SandboxService_GetClaim_FullMethodName        = "/srvpb.v1.SandboxService/GetClaim"
+	SandboxService_GetClaimHistory_FullMethodName = "/srvpb.v1.SandboxService/GetClaimHistory"
+	SandboxService_ListClaimEvents_FullMethodName = "/srvpb.v1.SandboxService/ListClaimEvents"
 )
 
 // SandboxServiceClient is the client API for SandboxService service.
@@ -39,12 +48,30 @@ const (
 type SandboxServiceClient interface {
 	// Check the health of the service. This is used by load balancers to check service health.
 	GetHealthCheck(ctx context.Context, in *v1.GetHealthCheckRequest, opts ...grpc.CallOption) (*v1.GetHealthCheckResponse, error)
+	// Report that the portal process is running, without checking its dependencies. Used as a liveness probe.
+	GetLiveness(ctx context.Context, in *v1.GetHealthCheckRequest, opts ...grpc.CallOption) (*v1.GetHealthCheckResponse, error)
+	// Check that the portal can serve requests: the gateway is reachable, the phylum is up and matches the portal version, and traces can be exported. Used as a readiness probe; fails with 503 and the reports when not ready.
+	GetReadiness(ctx context.Context, in *v1.GetHealthCheckRequest, opts ...grpc.CallOption) (*v1.GetHealthCheckResponse, error)
 	// Create claim initiates the creation of the claim.
 	CreateClaim(ctx context.Context, in *v11.CreateClaimRequest, opts ...grpc.CallOption) (*v11.CreateClaimResponse, error)
 	// Add claimant updates claim details.
 	AddClaimant(ctx context.Context, in *v11.AddClaimantRequest, opts ...grpc.CallOption) (*v11.AddClaimantResponse, error)
+	// Advance claim submits the connector response for the claim's current state.
+	AdvanceClaim(ctx context.Context, in *v11.AdvanceClaimRequest, opts ...grpc.CallOption) (*v11.AdvanceClaimResponse, error)
+	// Set claim status records the final outcome of the claim.
+	SetClaimStatus(ctx context.Context, in *v11.SetClaimStatusRequest, opts ...grpc.CallOption) (*v11.SetClaimStatusResponse, error)
+	// Decline claim declines the claim, halting its workflow.
+	DeclineClaim(ctx context.Context, in *v11.DeclineClaimRequest, opts ...grpc.CallOption) (*v11.DeclineClaimResponse, error)
+	// List claims, one page at a time.
+	ListClaims(ctx context.Context, in *v11.ListClaimsRequest, opts ...grpc.CallOption) (*v11.ListClaimsResponse, error)
+	// Export claims streams every claim, optionally filtered, as newline delimited JSON.
+	ExportClaims(ctx context.Context, in *v11.ExportClaimsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v11.ExportClaimsResponse], error)
 	// Retrieve claim details.
 	GetClaim(ctx context.Context, in *v11.GetClaimRequest, opts ...grpc.CallOption) (*v11.GetClaimResponse, error)
+	// Retrieve the state transitions of a claim, oldest first.
+	GetClaimHistory(ctx context.Context, in *v11.GetClaimHistoryRequest, opts ...grpc.CallOption) (*v11.GetClaimHistoryResponse, error)
+	// List the outstanding and completed connector events of a claim.
+	ListClaimEvents(ctx context.Context, in *v11.ListClaimEventsRequest, opts ...grpc.CallOption) (*v11.ListClaimEventsResponse, error)
 }
 
 type sandboxServiceClient struct {
@@ -65,6 +92,26 @@ func (c *sandboxServiceClient) GetHealthCheck(ctx context.Context, in *v1.GetHea
 	return out, nil
 }
 
+func (c *sandboxServiceClient) GetLiveness(ctx context.Context, in *v1.GetHealthCheckRequest, opts ...grpc.CallOption) (*v1.GetHealthCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetHealthCheckResponse)
+	err := c.cc.Invoke(ctx, SandboxService_GetLiveness_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) GetReadiness(ctx context.Context, in *v1.GetHealthCheckRequest, opts ...grpc.CallOption) (*v1.GetHealthCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.GetHealthCheckResponse)
+	err := c.cc.Invoke(ctx, SandboxService_GetReadiness_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sandboxServiceClient) CreateClaim(ctx context.Context, in *v11.CreateClaimRequest, opts ...grpc.CallOption) (*v11.CreateClaimResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(v11.CreateClaimResponse)
@@ -85,6 +132,65 @@ func (c *sandboxServiceClient) AddClaimant(ctx context.Context, in *v11.AddClaim
 	return out, nil
 }
 
+func (c *sandboxServiceClient) AdvanceClaim(ctx context.Context, in *v11.AdvanceClaimRequest, opts ...grpc.CallOption) (*v11.AdvanceClaimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.AdvanceClaimResponse)
+	err := c.cc.Invoke(ctx, SandboxService_AdvanceClaim_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) SetClaimStatus(ctx context.Context, in *v11.SetClaimStatusRequest, opts ...grpc.CallOption) (*v11.SetClaimStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.SetClaimStatusResponse)
+	err := c.cc.Invoke(ctx, SandboxService_SetClaimStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) DeclineClaim(ctx context.Context, in *v11.DeclineClaimRequest, opts ...grpc.CallOption) (*v11.DeclineClaimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.DeclineClaimResponse)
+	err := c.cc.Invoke(ctx, SandboxService_DeclineClaim_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) ListClaims(ctx context.Context, in *v11.ListClaimsRequest, opts ...grpc.CallOption) (*v11.ListClaimsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.ListClaimsResponse)
+	err := c.cc.Invoke(ctx, SandboxService_ListClaims_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) ExportClaims(ctx context.Context, in *v11.ExportClaimsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v11.ExportClaimsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SandboxService_ServiceDesc.Streams[0], SandboxService_ExportClaims_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[v11.ExportClaimsRequest, v11.ExportClaimsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SandboxService_ExportClaimsClient = grpc.ServerStreamingClient[v11.ExportClaimsResponse]
+
 func (c *sandboxServiceClient) GetClaim(ctx context.Context, in *v11.GetClaimRequest, opts ...grpc.CallOption) (*v11.GetClaimResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(v11.GetClaimResponse)
@@ -95,6 +201,26 @@ func (c *sandboxServiceClient) GetClaim(ctx context.Context, in *v11.GetClaimReq
 	return out, nil
 }
 
+func (c *sandboxServiceClient) GetClaimHistory(ctx context.Context, in *v11.GetClaimHistoryRequest, opts ...grpc.CallOption) (*v11.GetClaimHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.GetClaimHistoryResponse)
+	err := c.cc.Invoke(ctx, SandboxService_GetClaimHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sandboxServiceClient) ListClaimEvents(ctx context.Context, in *v11.ListClaimEventsRequest, opts ...grpc.CallOption) (*v11.ListClaimEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.ListClaimEventsResponse)
+	err := c.cc.Invoke(ctx, SandboxService_ListClaimEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SandboxServiceServer is the server API for SandboxService service.
 // All implementations must embed UnimplementedSandboxServiceServer
 // for forward compatibility.
@@ -103,12 +229,30 @@ func (c *sandboxServiceClient) GetClaim(ctx context.Context, in *v11.GetClaimReq
 type SandboxServiceServer interface {
 	// Check the health of the service. This is used by load balancers to check service health.
 	GetHealthCheck(context.Context, *v1.GetHealthCheckRequest) (*v1.GetHealthCheckResponse, error)
+	// Report that the portal process is running, without checking its dependencies. Used as a liveness probe.
+	GetLiveness(context.Context, *v1.GetHealthCheckRequest) (*v1.GetHealthCheckResponse, error)
+	// Check that the portal can serve requests: the gateway is reachable, the phylum is up and matches the portal version, and traces can be exported. Used as a readiness probe; fails with 503 and the reports when not ready.
+	GetReadiness(context.Context, *v1.GetHealthCheckRequest) (*v1.GetHealthCheckResponse, error)
 	// Create claim initiates the creation of the claim.
 	CreateClaim(context.Context, *v11.CreateClaimRequest) (*v11.CreateClaimResponse, error)
 	// Add claimant updates claim details.
 	AddClaimant(context.Context, *v11.AddClaimantRequest) (*v11.AddClaimantResponse, error)
+	// Advance claim submits the connector response for the claim's current state.
+	AdvanceClaim(context.Context, *v11.AdvanceClaimRequest) (*v11.AdvanceClaimResponse, error)
+	// Set claim status records the final outcome of the claim.
+	SetClaimStatus(context.Context, *v11.SetClaimStatusRequest) (*v11.SetClaimStatusResponse, error)
+	// Decline claim declines the claim, halting its workflow.
+	DeclineClaim(context.Context, *v11.DeclineClaimRequest) (*v11.DeclineClaimResponse, error)
+	// List claims, one page at a time.
+	ListClaims(context.Context, *v11.ListClaimsRequest) (*v11.ListClaimsResponse, error)
+	// Export claims streams every claim, optionally filtered, as newline delimited JSON.
+	ExportClaims(*v11.ExportClaimsRequest, grpc.ServerStreamingServer[v11.ExportClaimsResponse]) error
 	// Retrieve claim details.
 	GetClaim(context.Context, *v11.GetClaimRequest) (*v11.GetClaimResponse, error)
+	// Retrieve the state transitions of a claim, oldest first.
+	GetClaimHistory(context.Context, *v11.GetClaimHistoryRequest) (*v11.GetClaimHistoryResponse, error)
+	// List the outstanding and completed connector events of a claim.
+	ListClaimEvents(context.Context, *v11.ListClaimEventsRequest) (*v11.ListClaimEventsResponse, error)
 	mustEmbedUnimplementedSandboxServiceServer()
 }
 
@@ -122,15 +266,42 @@ type UnimplementedSandboxServiceServer struct{}
 func (UnimplementedSandboxServiceServer) GetHealthCheck(context.Context, *v1.GetHealthCheckRequest) (*v1.GetHealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHealthCheck not implemented")
 }
+func (UnimplementedSandboxServiceServer) GetLiveness(context.Context, *v1.GetHealthCheckRequest) (*v1.GetHealthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiveness not implemented")
+}
+func (UnimplementedSandboxServiceServer) GetReadiness(context.Context, *v1.GetHealthCheckRequest) (*v1.GetHealthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReadiness not implemented")
+}
 func (UnimplementedSandboxServiceServer) CreateClaim(context.Context, *v11.CreateClaimRequest) (*v11.CreateClaimResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateClaim not implemented")
 }
 func (UnimplementedSandboxServiceServer) AddClaimant(context.Context, *v11.AddClaimantRequest) (*v11.AddClaimantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddClaimant not implemented")
 }
+func (UnimplementedSandboxServiceServer) AdvanceClaim(context.Context, *v11.AdvanceClaimRequest) (*v11.AdvanceClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdvanceClaim not implemented")
+}
+func (UnimplementedSandboxServiceServer) SetClaimStatus(context.Context, *v11.SetClaimStatusRequest) (*v11.SetClaimStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClaimStatus not implemented")
+}
+func (UnimplementedSandboxServiceServer) DeclineClaim(context.Context, *v11.DeclineClaimRequest) (*v11.DeclineClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineClaim not implemented")
+}
+func (UnimplementedSandboxServiceServer) ListClaims(context.Context, *v11.ListClaimsRequest) (*v11.ListClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClaims not implemented")
+}
+func (UnimplementedSandboxServiceServer) ExportClaims(*v11.ExportClaimsRequest, grpc.ServerStreamingServer[v11.ExportClaimsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportClaims not implemented")
+}
 func (UnimplementedSandboxServiceServer) GetClaim(context.Context, *v11.GetClaimRequest) (*v11.GetClaimResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetClaim not implemented")
 }
+func (UnimplementedSandboxServiceServer) GetClaimHistory(context.Context, *v11.GetClaimHistoryRequest) (*v11.GetClaimHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClaimHistory not implemented")
+}
+func (UnimplementedSandboxServiceServer) ListClaimEvents(context.Context, *v11.ListClaimEventsRequest) (*v11.ListClaimEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClaimEvents not implemented")
+}
 func (UnimplementedSandboxServiceServer) mustEmbedUnimplementedSandboxServiceServer() {}
 func (UnimplementedSandboxServiceServer) testEmbeddedByValue()                        {}
 
@@ -170,6 +341,42 @@ func _SandboxService_GetHealthCheck_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SandboxService_GetLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetHealthCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).GetLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SandboxService_GetLiveness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).GetLiveness(ctx, req.(*v1.GetHealthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_GetReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetHealthCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).GetReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SandboxService_GetReadiness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).GetReadiness(ctx, req.(*v1.GetHealthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SandboxService_CreateClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v11.CreateClaimRequest)
 	if err := dec(in); err != nil {
@@ -206,6 +413,89 @@ func _SandboxService_AddClaimant_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SandboxService_AdvanceClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.AdvanceClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).AdvanceClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SandboxService_AdvanceClaim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).AdvanceClaim(ctx, req.(*v11.AdvanceClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_SetClaimStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.SetClaimStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).SetClaimStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SandboxService_SetClaimStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).SetClaimStatus(ctx, req.(*v11.SetClaimStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_DeclineClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.DeclineClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).DeclineClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SandboxService_DeclineClaim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).DeclineClaim(ctx, req.(*v11.DeclineClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_ListClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.ListClaimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).ListClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SandboxService_ListClaims_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).ListClaims(ctx, req.(*v11.ListClaimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_ExportClaims_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(v11.ExportClaimsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SandboxServiceServer).ExportClaims(m, &grpc.GenericServerStream[v11.ExportClaimsRequest, v11.ExportClaimsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SandboxService_ExportClaimsServer = grpc.ServerStreamingServer[v11.ExportClaimsResponse]
+
 func _SandboxService_GetClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v11.GetClaimRequest)
 	if err := dec(in); err != nil {
@@ -224,6 +514,42 @@ func _SandboxService_GetClaim_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SandboxService_GetClaimHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.GetClaimHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).GetClaimHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SandboxService_GetClaimHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).GetClaimHistory(ctx, req.(*v11.GetClaimHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SandboxService_ListClaimEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.ListClaimEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SandboxServiceServer).ListClaimEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SandboxService_ListClaimEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SandboxServiceServer).ListClaimEvents(ctx, req.(*v11.ListClaimEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SandboxService_ServiceDesc is the grpc.ServiceDesc for SandboxService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -235,6 +561,14 @@ var SandboxService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetHealthCheck",
 			Handler:    _SandboxService_GetHealthCheck_Handler,
 		},
+		{
+			MethodName: "GetLiveness",
+			Handler:    _SandboxService_GetLiveness_Handler,
+		},
+		{
+			MethodName: "GetReadiness",
+			Handler:    _SandboxService_GetReadiness_Handler,
+		},
 		{
 			MethodName: "CreateClaim",
 			Handler:    _SandboxService_CreateClaim_Handler,
@@ -243,11 +577,189 @@ var SandboxService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddClaimant",
 			Handler:    _SandboxService_AddClaimant_Handler,
 		},
+		{
+			MethodName: "AdvanceClaim",
+			Handler:    _SandboxService_AdvanceClaim_Handler,
+		},
+		{
+			MethodName: "SetClaimStatus",
+			Handler:    _SandboxService_SetClaimStatus_Handler,
+		},
+		{
+			MethodName: "DeclineClaim",
+			Handler:    _SandboxService_DeclineClaim_Handler,
+		},
+		{
+			MethodName: "ListClaims",
+			Handler:    _SandboxService_ListClaims_Handler,
+		},
 		{
 			MethodName: "GetClaim",
 			Handler:    _SandboxService_GetClaim_Handler,
 		},
+		{
+			MethodName: "GetClaimHistory",
+			Handler:    _SandboxService_GetClaimHistory_Handler,
+		},
+		{
+			MethodName: "ListClaimEvents",
+			Handler:    _SandboxService_ListClaimEvents_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportClaims",
+			Handler:       _SandboxService_ExportClaims_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "srvpb/v1/oracle.proto",
+}
+
+const (
+	AdminService_TakeSnapshot_FullMethodName    = "/srvpb.v1.AdminService/TakeSnapshot"
+	AdminService_RestoreSnapshot_FullMethodName = "/srvpb.v1.AdminService/RestoreSnapshot"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Administration of an emulated (in-memory) sandbox.  Calls require the admin
+// key, and are rejected when the sandbox runs against a real network.
+type AdminServiceClient interface {
+	// Take snapshot streams the state of the emulated phylum.
+	TakeSnapshot(ctx context.Context, in *v11.TakeSnapshotRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v11.TakeSnapshotResponse], error)
+	// Restore snapshot replaces the state of the emulated phylum with a snapshot
+	// taken by TakeSnapshot.
+	RestoreSnapshot(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[v11.RestoreSnapshotRequest, v11.RestoreSnapshotResponse], error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) TakeSnapshot(ctx context.Context, in *v11.TakeSnapshotRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v11.TakeSnapshotResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AdminService_ServiceDesc.Streams[0], AdminService_TakeSnapshot_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[v11.TakeSnapshotRequest, v11.TakeSnapshotResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdminService_TakeSnapshotClient = grpc.ServerStreamingClient[v11.TakeSnapshotResponse]
+
+func (c *adminServiceClient) RestoreSnapshot(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[v11.RestoreSnapshotRequest, v11.RestoreSnapshotResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AdminService_ServiceDesc.Streams[1], AdminService_RestoreSnapshot_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[v11.RestoreSnapshotRequest, v11.RestoreSnapshotResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdminService_RestoreSnapshotClient = grpc.ClientStreamingClient[v11.RestoreSnapshotRequest, v11.RestoreSnapshotResponse]
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+//
+// Administration of an emulated (in-memory) sandbox.  Calls require the admin
+// key, and are rejected when the sandbox runs against a real network.
+type AdminServiceServer interface {
+	// Take snapshot streams the state of the emulated phylum.
+	TakeSnapshot(*v11.TakeSnapshotRequest, grpc.ServerStreamingServer[v11.TakeSnapshotResponse]) error
+	// Restore snapshot replaces the state of the emulated phylum with a snapshot
+	// taken by TakeSnapshot.
+	RestoreSnapshot(grpc.ClientStreamingServer[v11.RestoreSnapshotRequest, v11.RestoreSnapshotResponse]) error
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) TakeSnapshot(*v11.TakeSnapshotRequest, grpc.ServerStreamingServer[v11.TakeSnapshotResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method TakeSnapshot not implemented")
+}
+func (UnimplementedAdminServiceServer) RestoreSnapshot(grpc.ClientStreamingServer[v11.RestoreSnapshotRequest, v11.RestoreSnapshotResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method RestoreSnapshot not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_TakeSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(v11.TakeSnapshotRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).TakeSnapshot(m, &grpc.GenericServerStream[v11.TakeSnapshotRequest, v11.TakeSnapshotResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdminService_TakeSnapshotServer = grpc.ServerStreamingServer[v11.TakeSnapshotResponse]
+
+func _AdminService_RestoreSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AdminServiceServer).RestoreSnapshot(&grpc.GenericServerStream[v11.RestoreSnapshotRequest, v11.RestoreSnapshotResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdminService_RestoreSnapshotServer = grpc.ClientStreamingServer[v11.RestoreSnapshotRequest, v11.RestoreSnapshotResponse]
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "srvpb.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TakeSnapshot",
+			Handler:       _AdminService_TakeSnapshot_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RestoreSnapshot",
+			Handler:       _AdminService_RestoreSnapshot_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "srvpb/v1/oracle.proto",
 }
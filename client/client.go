@@ -0,0 +1,285 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+// Package client calls the sandbox portal's SandboxService over gRPC.
+//
+// Exceptions returned by the portal, whether in a response or as a status
+// error, are returned as typed errors which callers inspect with errors.As:
+//
+//	c, err := client.New("sandbox.example.com:443", client.WithAPIKey(key))
+//	...
+//	claim, err := c.GetClaim(ctx, id)
+//	var notFound *client.NotFoundError
+//	if errors.As(err, &notFound) {
+//		...
+//	}
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	common "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/common/v1"
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	srv "github.com/luthersystems/sandbox/api/srvpb/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// apiKeyMetadata is the metadata key carrying the caller's API key.
+	apiKeyMetadata = "x-api-key"
+	// idempotencyKeyMetadata is the metadata key carrying the key which
+	// identifies retries of a mutating call.
+	idempotencyKeyMetadata = "idempotency-key"
+)
+
+type options struct {
+	creds       credentials.TransportCredentials
+	apiKey      string
+	retry       RetryPolicy
+	timeout     time.Duration
+	dialOptions []grpc.DialOption
+}
+
+// Option configures a Client.
+type Option func(*options)
+
+// WithTLS connects to the portal over TLS configured by cfg.  Clients
+// connect over TLS, verifying the portal against the system roots, by
+// default.
+func WithTLS(cfg *tls.Config) Option {
+	return func(o *options) {
+		o.creds = credentials.NewTLS(cfg)
+	}
+}
+
+// WithInsecure connects to the portal without TLS, e.g. to a portal
+// running locally.
+func WithInsecure() Option {
+	return func(o *options) {
+		o.creds = insecure.NewCredentials()
+	}
+}
+
+// WithAPIKey sends key with each call to authenticate the caller.
+func WithAPIKey(key string) Option {
+	return func(o *options) {
+		o.apiKey = key
+	}
+}
+
+// WithRetryPolicy sets the retry policy of the client, in place of
+// DefaultRetryPolicy.  Use a zero RetryPolicy to disable retries.
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(o *options) {
+		o.retry = p
+	}
+}
+
+// WithTimeout limits each unary call, including its retries, to d unless
+// its context already has a deadline.
+func WithTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.timeout = d
+	}
+}
+
+// WithDialOptions adds options to the gRPC connection of the client.
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(o *options) {
+		o.dialOptions = append(o.dialOptions, opts...)
+	}
+}
+
+// Client calls the portal's SandboxService.  Mutating calls carry an
+// idempotency key, so that they are only applied once when retried.
+type Client struct {
+	conn *grpc.ClientConn
+	svc  srv.SandboxServiceClient
+}
+
+// New returns a client for the portal's gRPC API at address.
+func New(address string, opts ...Option) (*Client, error) {
+	o := &options{
+		creds: credentials.NewTLS(nil),
+		retry: DefaultRetryPolicy,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	var unary []grpc.UnaryClientInterceptor
+	var stream []grpc.StreamClientInterceptor
+	if o.timeout > 0 {
+		unary = append(unary, timeoutInterceptor(o.timeout))
+	}
+	unary = append(unary,
+		idempotencyInterceptor(mutatingMethods(&srv.SandboxService_ServiceDesc)),
+		o.retry.interceptor())
+	if o.apiKey != "" {
+		unary = append(unary, func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			ctx = metadata.AppendToOutgoingContext(ctx, apiKeyMetadata, o.apiKey)
+			return invoker(ctx, method, req, reply, cc, opts...)
+		})
+		stream = append(stream, func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			ctx = metadata.AppendToOutgoingContext(ctx, apiKeyMetadata, o.apiKey)
+			return streamer(ctx, desc, cc, method, opts...)
+		})
+	}
+	dialOptions := append([]grpc.DialOption{
+		grpc.WithTransportCredentials(o.creds),
+		grpc.WithChainUnaryInterceptor(unary...),
+		grpc.WithChainStreamInterceptor(stream...),
+	}, o.dialOptions...)
+	conn, err := grpc.NewClient(address, dialOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("dial %s: %w", address, err)
+	}
+	return &Client{conn: conn, svc: srv.NewSandboxServiceClient(conn)}, nil
+}
+
+// timeoutInterceptor limits calls without a deadline to d.
+func timeoutInterceptor(d time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, d)
+			defer cancel()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// Close closes the connection of the client.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Conn returns the connection of the client, for clients of the portal's
+// other services.  Their calls are retried and authenticated as those of c.
+func (c *Client) Conn() *grpc.ClientConn {
+	return c.conn
+}
+
+// Service returns the underlying SandboxService client, for calls which
+// need the raw responses.  Its calls are retried and authenticated as those
+// of c, but their exceptions are not converted to errors.
+func (c *Client) Service() srv.SandboxServiceClient {
+	return c.svc
+}
+
+// claimResponse is a response returning a claim or an exception.
+type claimResponse interface {
+	GetClaim() *pb.Claim
+	GetException() *common.Exception
+}
+
+// claimResult returns the claim of resp, or the error for its exception.
+func claimResult[R claimResponse](resp R, err error) (*pb.Claim, error) {
+	if err != nil {
+		return nil, convertError(err)
+	}
+	if err := exceptionError(resp.GetException()); err != nil {
+		return nil, err
+	}
+	return resp.GetClaim(), nil
+}
+
+// CreateClaim creates a claim.
+func (c *Client) CreateClaim(ctx context.Context, req *pb.CreateClaimRequest) (*pb.Claim, error) {
+	return claimResult(c.svc.CreateClaim(ctx, req))
+}
+
+// AddClaimant adds the claimant to a claim.
+func (c *Client) AddClaimant(ctx context.Context, req *pb.AddClaimantRequest) (*pb.Claim, error) {
+	return claimResult(c.svc.AddClaimant(ctx, req))
+}
+
+// AdvanceClaim moves a claim on to the next state in its workflow.
+func (c *Client) AdvanceClaim(ctx context.Context, req *pb.AdvanceClaimRequest) (*pb.Claim, error) {
+	return claimResult(c.svc.AdvanceClaim(ctx, req))
+}
+
+// SetClaimStatus records the final outcome of a claim.
+func (c *Client) SetClaimStatus(ctx context.Context, req *pb.SetClaimStatusRequest) (*pb.Claim, error) {
+	return claimResult(c.svc.SetClaimStatus(ctx, req))
+}
+
+// DeclineClaim declines a claim, halting its workflow.
+func (c *Client) DeclineClaim(ctx context.Context, req *pb.DeclineClaimRequest) (*pb.Claim, error) {
+	return claimResult(c.svc.DeclineClaim(ctx, req))
+}
+
+// GetClaim returns the claim with the given ID.
+func (c *Client) GetClaim(ctx context.Context, claimID string) (*pb.Claim, error) {
+	return claimResult(c.svc.GetClaim(ctx, &pb.GetClaimRequest{ClaimId: claimID}))
+}
+
+// GetClaimHistory returns the state transitions of a claim, oldest first.
+func (c *Client) GetClaimHistory(ctx context.Context, claimID string) ([]*pb.ClaimTransition, error) {
+	resp, err := c.svc.GetClaimHistory(ctx, &pb.GetClaimHistoryRequest{ClaimId: claimID})
+	if err != nil {
+		return nil, convertError(err)
+	}
+	if err := exceptionError(resp.GetException()); err != nil {
+		return nil, err
+	}
+	return resp.GetTransitions(), nil
+}
+
+// ListClaimEvents returns the outstanding and completed connector events of
+// a claim.
+func (c *Client) ListClaimEvents(ctx context.Context, claimID string) (*pb.ListClaimEventsResponse, error) {
+	resp, err := c.svc.ListClaimEvents(ctx, &pb.ListClaimEventsRequest{ClaimId: claimID})
+	if err != nil {
+		return nil, convertError(err)
+	}
+	if err := exceptionError(resp.GetException()); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ListClaims returns a page of claims.
+func (c *Client) ListClaims(ctx context.Context, req *pb.ListClaimsRequest) (*pb.ListClaimsResponse, error) {
+	resp, err := c.svc.ListClaims(ctx, req)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	if err := exceptionError(resp.GetException()); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ExportClaims calls fn with each claim matching the request filters, in
+// the order the portal streams them.  It stops at the first error returned
+// by fn.
+func (c *Client) ExportClaims(ctx context.Context, req *pb.ExportClaimsRequest, fn func(*pb.Claim) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.svc.ExportClaims(ctx, req)
+	if err != nil {
+		return convertError(err)
+	}
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return convertError(err)
+		}
+		if err := exceptionError(resp.GetException()); err != nil {
+			return err
+		}
+		if err := fn(resp.GetClaim()); err != nil {
+			return err
+		}
+	}
+}
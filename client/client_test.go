@@ -0,0 +1,316 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	common "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/common/v1"
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	srv "github.com/luthersystems/sandbox/api/srvpb/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeService answers calls with canned responses and records the metadata
+// of each call.
+type fakeService struct {
+	srv.UnimplementedSandboxServiceServer
+	mu sync.Mutex
+	// failures is the number of calls which fail with Unavailable before
+	// calls succeed.
+	failures int
+	calls    []metadata.MD
+	claims   map[string]*pb.Claim
+}
+
+func (s *fakeService) record(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.calls = append(s.calls, md)
+	if s.failures > 0 {
+		s.failures--
+		return status.Error(codes.Unavailable, "try again")
+	}
+	return nil
+}
+
+func (s *fakeService) CreateClaim(ctx context.Context, req *pb.CreateClaimRequest) (*pb.CreateClaimResponse, error) {
+	if err := s.record(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetDamageAmount() < 0 {
+		return &pb.CreateClaimResponse{Result: &pb.CreateClaimResponse_Exception{
+			Exception: &common.Exception{Type: common.Exception_BUSINESS, Description: "negative damage"},
+		}}, nil
+	}
+	return &pb.CreateClaimResponse{Result: &pb.CreateClaimResponse_Claim{
+		Claim: &pb.Claim{ClaimId: "new", DamageAmount: req.GetDamageAmount()},
+	}}, nil
+}
+
+func (s *fakeService) GetClaim(ctx context.Context, req *pb.GetClaimRequest) (*pb.GetClaimResponse, error) {
+	if err := s.record(ctx); err != nil {
+		return nil, err
+	}
+	claim, ok := s.claims[req.GetClaimId()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "missing claim")
+	}
+	return &pb.GetClaimResponse{Result: &pb.GetClaimResponse_Claim{Claim: claim}}, nil
+}
+
+func (s *fakeService) AddClaimant(ctx context.Context, _ *pb.AddClaimantRequest) (*pb.AddClaimantResponse, error) {
+	if err := s.record(ctx); err != nil {
+		return nil, err
+	}
+	ex := &common.Exception{
+		Type:              common.Exception_BUSINESS,
+		Description:       "claim has changed",
+		ExceptionMetadata: map[string]string{conflictMetadataKey: "revision", revisionMetadataKey: "7"},
+	}
+	stat, err := status.New(codes.Aborted, ex.GetDescription()).WithDetails(ex)
+	if err != nil {
+		return nil, err
+	}
+	return nil, stat.Err()
+}
+
+func (s *fakeService) ExportClaims(req *pb.ExportClaimsRequest, stream srv.SandboxService_ExportClaimsServer) error {
+	if err := s.record(stream.Context()); err != nil {
+		return err
+	}
+	for _, id := range []string{"a", "b", "c"} {
+		if err := stream.Send(&pb.ExportClaimsResponse{Claim: &pb.Claim{ClaimId: id}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newTestClient(t *testing.T, svc *fakeService, opts ...Option) *Client {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	srv.RegisterSandboxServiceServer(server, svc)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+	opts = append([]Option{
+		WithInsecure(),
+		WithDialOptions(grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		})),
+	}, opts...)
+	c, err := New("passthrough:///bufnet", opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, c.Close()) })
+	return c
+}
+
+func TestClientErrors(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t, &fakeService{claims: map[string]*pb.Claim{"a": {ClaimId: "a"}}})
+
+	claim, err := c.GetClaim(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, "a", claim.GetClaimId())
+
+	_, err = c.GetClaim(ctx, "missing")
+	var notFound *NotFoundError
+	require.ErrorAs(t, err, &notFound)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = c.CreateClaim(ctx, &pb.CreateClaimRequest{DamageAmount: -1})
+	var business *BusinessError
+	require.ErrorAs(t, err, &business)
+	assert.Equal(t, "negative damage", business.Exception.GetDescription())
+	var statusErr *StatusError
+	require.ErrorAs(t, err, &statusErr, "typed errors wrap a StatusError")
+	assert.Equal(t, codes.InvalidArgument, statusErr.Code)
+
+	_, err = c.AddClaimant(ctx, &pb.AddClaimantRequest{ClaimId: "a"})
+	var conflict *ConflictError
+	require.ErrorAs(t, err, &conflict)
+	assert.Equal(t, int64(7), conflict.Revision())
+	stat, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Len(t, stat.Details(), 1, "the exception is kept in the status")
+
+	_, err = c.ListClaims(ctx, &pb.ListClaimsRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+	assert.False(t, errors.As(err, &business))
+}
+
+func TestConvertError(t *testing.T) {
+	for _, tc := range []struct {
+		code   codes.Code
+		target any
+	}{
+		{codes.InvalidArgument, new(*BusinessError)},
+		{codes.FailedPrecondition, new(*BusinessError)},
+		{codes.NotFound, new(*NotFoundError)},
+		{codes.Aborted, new(*ConflictError)},
+		{codes.Unauthenticated, new(*UnauthenticatedError)},
+		{codes.PermissionDenied, new(*PermissionDeniedError)},
+		{codes.Unavailable, new(*UnavailableError)},
+		{codes.Internal, new(*InternalError)},
+		{codes.Unimplemented, new(*StatusError)},
+	} {
+		t.Run(tc.code.String(), func(t *testing.T) {
+			err := convertError(status.Error(tc.code, "boom"))
+			assert.ErrorAs(t, err, tc.target)
+			assert.Equal(t, tc.code, status.Code(err))
+		})
+	}
+
+	// Business exceptions are carried within the response.
+	ex := &common.Exception{Type: common.Exception_BUSINESS, Description: "negative damage"}
+	stat, err := status.New(codes.InvalidArgument, "negative damage").WithDetails(&pb.CreateClaimResponse{
+		Result: &pb.CreateClaimResponse_Exception{Exception: ex},
+	})
+	require.NoError(t, err)
+	var business *BusinessError
+	require.ErrorAs(t, convertError(stat.Err()), &business)
+	assert.True(t, proto.Equal(ex, business.Exception))
+
+	deadline := status.Error(codes.DeadlineExceeded, "too slow")
+	assert.Same(t, deadline, convertError(deadline))
+	plain := errors.New("boom")
+	assert.Same(t, plain, convertError(plain))
+}
+
+func TestExceptionError(t *testing.T) {
+	assert.NoError(t, exceptionError(nil))
+	for _, tc := range []struct {
+		typ    common.Exception_Type
+		target any
+	}{
+		{common.Exception_BUSINESS, new(*BusinessError)},
+		{common.Exception_SERVICE_NOT_AVAILABLE, new(*UnavailableError)},
+		{common.Exception_INFRASTRUCTURE, new(*InternalError)},
+		{common.Exception_UNEXPECTED, new(*InternalError)},
+		{common.Exception_SECURITY_VIOLATION, new(*PermissionDeniedError)},
+	} {
+		t.Run(tc.typ.String(), func(t *testing.T) {
+			err := exceptionError(&common.Exception{Type: tc.typ, Description: "boom"})
+			assert.ErrorAs(t, err, tc.target)
+		})
+	}
+
+	// Missing resources and conflicts are marked by the exception metadata.
+	err := exceptionError(&common.Exception{
+		Type:              common.Exception_BUSINESS,
+		Description:       "claim not found",
+		ExceptionMetadata: map[string]string{notFoundMetadataKey: "claim"},
+	})
+	var notFound *NotFoundError
+	assert.ErrorAs(t, err, &notFound)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	err = exceptionError(&common.Exception{
+		Type:              common.Exception_BUSINESS,
+		Description:       "claim has changed",
+		ExceptionMetadata: map[string]string{conflictMetadataKey: "revision"},
+	})
+	var conflict *ConflictError
+	assert.ErrorAs(t, err, &conflict)
+}
+
+func TestClientRetry(t *testing.T) {
+	ctx := context.Background()
+	svc := &fakeService{failures: 2}
+	c := newTestClient(t, svc, WithAPIKey("secret"), WithRetryPolicy(RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+	}))
+
+	claim, err := c.CreateClaim(ctx, &pb.CreateClaimRequest{DamageAmount: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), claim.GetDamageAmount())
+	require.Len(t, svc.calls, 3)
+	key := svc.calls[0].Get(idempotencyKeyMetadata)
+	require.Len(t, key, 1, "mutating calls carry an idempotency key")
+	for _, md := range svc.calls {
+		assert.Equal(t, key, md.Get(idempotencyKeyMetadata), "retries reuse the key")
+		assert.Equal(t, []string{"secret"}, md.Get(apiKeyMetadata))
+	}
+
+	// A key chosen by the caller is kept.
+	svc.calls = nil
+	_, err = c.CreateClaim(metadata.AppendToOutgoingContext(ctx, idempotencyKeyMetadata, "mine"), &pb.CreateClaimRequest{})
+	require.NoError(t, err)
+	require.Len(t, svc.calls, 1)
+	assert.Equal(t, []string{"mine"}, svc.calls[0].Get(idempotencyKeyMetadata))
+
+	// Queries carry no idempotency key.
+	svc.calls = nil
+	_, err = c.GetClaim(ctx, "missing")
+	require.Error(t, err)
+	require.Len(t, svc.calls, 1, "not found is not retried")
+	assert.Empty(t, svc.calls[0].Get(idempotencyKeyMetadata))
+
+	// Calls fail once the attempts are exhausted.
+	svc.calls = nil
+	svc.failures = 5
+	_, err = c.GetClaim(ctx, "missing")
+	var unavailable *UnavailableError
+	require.ErrorAs(t, err, &unavailable)
+	assert.Len(t, svc.calls, 3)
+}
+
+func TestClientTimeout(t *testing.T) {
+	svc := &fakeService{failures: 100}
+	c := newTestClient(t, svc, WithTimeout(50*time.Millisecond), WithRetryPolicy(RetryPolicy{
+		MaxAttempts:    100,
+		InitialBackoff: 20 * time.Millisecond,
+		MaxBackoff:     20 * time.Millisecond,
+	}))
+	start := time.Now()
+	_, err := c.GetClaim(context.Background(), "a")
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), time.Second, "retries stop at the timeout")
+}
+
+func TestClientExport(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t, &fakeService{}, WithAPIKey("secret"))
+	var ids []string
+	err := c.ExportClaims(ctx, &pb.ExportClaimsRequest{}, func(claim *pb.Claim) error {
+		ids = append(ids, claim.GetClaimId())
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, ids)
+
+	stop := errors.New("stop")
+	ids = nil
+	err = c.ExportClaims(ctx, &pb.ExportClaimsRequest{}, func(claim *pb.Claim) error {
+		ids = append(ids, claim.GetClaimId())
+		return stop
+	})
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, []string{"a"}, ids)
+}
+
+func TestRetryBackoff(t *testing.T) {
+	p := RetryPolicy{InitialBackoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond}
+	for attempts := 1; attempts < 100; attempts++ {
+		d := p.backoff(attempts)
+		assert.GreaterOrEqual(t, d, time.Duration(0))
+		assert.Less(t, d, p.MaxBackoff)
+	}
+	assert.Less(t, p.backoff(1), p.InitialBackoff)
+	assert.Zero(t, RetryPolicy{}.backoff(1))
+}
@@ -0,0 +1,191 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	common "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/common/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StatusError is an error reported by the portal.  Errors reported for the
+// common status codes are returned as one of the more specific types below,
+// each of which wraps a StatusError.
+type StatusError struct {
+	// Code is the gRPC status code of the error.
+	Code codes.Code
+	// Message describes the error.
+	Message string
+	// Exception is the exception returned by the portal, if any.
+	Exception *common.Exception
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("sandbox: %s: %s", e.Code, e.Message)
+}
+
+// GRPCStatus returns the status of the error, so that status.Code and
+// status.FromError work on every error returned by the client.
+func (e *StatusError) GRPCStatus() *status.Status {
+	stat := status.New(e.Code, e.Message)
+	if e.Exception == nil {
+		return stat
+	}
+	if detailed, err := stat.WithDetails(e.Exception); err == nil {
+		return detailed
+	}
+	return stat
+}
+
+// BusinessError reports a request the portal rejected, e.g. because it was
+// invalid or not allowed in the current state of the claim.
+type BusinessError struct{ *StatusError }
+
+func (e *BusinessError) Unwrap() error { return e.StatusError }
+
+// NotFoundError reports a request for a claim which does not exist.
+type NotFoundError struct{ *StatusError }
+
+func (e *NotFoundError) Unwrap() error { return e.StatusError }
+
+// ConflictError reports an update rejected because the claim has changed
+// since the revision the request expected.
+type ConflictError struct{ *StatusError }
+
+func (e *ConflictError) Unwrap() error { return e.StatusError }
+
+// Revision returns the current revision of the claim, or zero if the portal
+// did not report it.
+func (e *ConflictError) Revision() int64 {
+	revision, _ := strconv.ParseInt(e.Exception.GetExceptionMetadata()[revisionMetadataKey], 10, 64)
+	return revision
+}
+
+// UnauthenticatedError reports a request with missing or invalid
+// credentials.
+type UnauthenticatedError struct{ *StatusError }
+
+func (e *UnauthenticatedError) Unwrap() error { return e.StatusError }
+
+// PermissionDeniedError reports a request the caller is not authorized to
+// make.
+type PermissionDeniedError struct{ *StatusError }
+
+func (e *PermissionDeniedError) Unwrap() error { return e.StatusError }
+
+// UnavailableError reports that the portal, or the platform behind it, was
+// unable to serve the request.  The request may succeed if retried later.
+type UnavailableError struct{ *StatusError }
+
+func (e *UnavailableError) Unwrap() error { return e.StatusError }
+
+// InternalError reports an unexpected failure of the portal or the
+// platform.
+type InternalError struct{ *StatusError }
+
+func (e *InternalError) Unwrap() error { return e.StatusError }
+
+const (
+	// conflictMetadataKey marks exceptions reporting revision conflicts.
+	conflictMetadataKey = "conflict"
+	// notFoundMetadataKey marks exceptions reporting missing resources.
+	notFoundMetadataKey = "not_found"
+	// revisionMetadataKey holds the current revision of the claim in
+	// exceptions reporting revision conflicts.
+	revisionMetadataKey = "revision"
+)
+
+// exceptionCode returns the status code matching the type of ex, which is
+// the code the portal uses when it returns ex as a status error.
+func exceptionCode(ex *common.Exception) codes.Code {
+	md := ex.GetExceptionMetadata()
+	switch {
+	case md[conflictMetadataKey] != "":
+		return codes.Aborted
+	case md[notFoundMetadataKey] != "":
+		return codes.NotFound
+	}
+	switch ex.GetType() {
+	case common.Exception_BUSINESS:
+		return codes.InvalidArgument
+	case common.Exception_SERVICE_NOT_AVAILABLE:
+		return codes.Unavailable
+	case common.Exception_INFRASTRUCTURE:
+		return codes.DataLoss
+	case common.Exception_UNEXPECTED:
+		return codes.Unknown
+	case common.Exception_SECURITY_VIOLATION:
+		return codes.PermissionDenied
+	}
+	return codes.Internal
+}
+
+// exceptionError returns the typed error for an exception returned in a
+// response, or nil if ex is nil.
+func exceptionError(ex *common.Exception) error {
+	if ex == nil {
+		return nil
+	}
+	return typedError(&StatusError{
+		Code:      exceptionCode(ex),
+		Message:   ex.GetDescription(),
+		Exception: ex,
+	})
+}
+
+// convertError returns the typed error for an error returned by a call.
+// Cancellation and deadline errors are returned unchanged, as are errors
+// which do not carry a status.
+func convertError(err error) error {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	stat, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	switch stat.Code() {
+	case codes.Canceled, codes.DeadlineExceeded:
+		return err
+	}
+	se := &StatusError{Code: stat.Code(), Message: stat.Message()}
+	// The portal attaches the exception itself, or for business exceptions
+	// the response carrying it.
+	for _, d := range stat.Details() {
+		if ex, ok := d.(*common.Exception); ok {
+			se.Exception = ex
+			break
+		}
+		if r, ok := d.(interface{ GetException() *common.Exception }); ok && r.GetException() != nil {
+			se.Exception = r.GetException()
+			break
+		}
+	}
+	return typedError(se)
+}
+
+// typedError wraps se in the error type matching its code.
+func typedError(se *StatusError) error {
+	switch se.Code {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return &BusinessError{se}
+	case codes.NotFound:
+		return &NotFoundError{se}
+	case codes.Aborted, codes.AlreadyExists:
+		return &ConflictError{se}
+	case codes.Unauthenticated:
+		return &UnauthenticatedError{se}
+	case codes.PermissionDenied:
+		return &PermissionDeniedError{se}
+	case codes.Unavailable, codes.ResourceExhausted:
+		return &UnavailableError{se}
+	case codes.Internal, codes.Unknown, codes.DataLoss:
+		return &InternalError{se}
+	}
+	return se
+}
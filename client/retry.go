@@ -0,0 +1,116 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// RetryPolicy configures how calls which fail because the portal is
+// unavailable are retried.  Backoff between attempts grows exponentially
+// from InitialBackoff up to MaxBackoff, with full jitter.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of a call, including
+	// the first.  Calls are not retried if it is less than 2.
+	MaxAttempts int
+	// InitialBackoff is the maximum backoff before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the backoff between any two attempts.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is the retry policy of clients which do not set one.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    4,
+	InitialBackoff: 100 * time.Millisecond,
+	MaxBackoff:     2 * time.Second,
+}
+
+// backoff returns the time to wait before retrying after the given number of
+// failed attempts.
+func (p RetryPolicy) backoff(attempts int) time.Duration {
+	ceiling := p.MaxBackoff
+	if shift := attempts - 1; shift < 32 {
+		if d := p.InitialBackoff << shift; d > 0 && d < ceiling {
+			ceiling = d
+		}
+	}
+	if ceiling <= 0 {
+		return 0
+	}
+	return rand.N(ceiling)
+}
+
+// retryable reports whether a call which failed with err may be retried.
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted:
+		return true
+	}
+	return false
+}
+
+// interceptor retries unary calls according to the policy.
+func (p RetryPolicy) interceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		for attempt := 1; ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil || attempt >= p.MaxAttempts || !retryable(err) {
+				return err
+			}
+			timer := time.NewTimer(p.backoff(attempt))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			case <-timer.C:
+			}
+		}
+	}
+}
+
+// mutatingMethods returns the full names of the methods of the service
+// which are not mapped to HTTP GET, i.e. those which change state.
+func mutatingMethods(desc *grpc.ServiceDesc) map[string]bool {
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(desc.ServiceName))
+	if err != nil {
+		panic(fmt.Sprintf("service %s: %v", desc.ServiceName, err))
+	}
+	methods := make(map[string]bool)
+	for _, m := range desc.Methods {
+		md := d.(protoreflect.ServiceDescriptor).Methods().ByName(protoreflect.Name(m.MethodName))
+		rule, _ := proto.GetExtension(md.Options(), annotations.E_Http).(*annotations.HttpRule)
+		if rule.GetGet() == "" {
+			methods["/"+desc.ServiceName+"/"+m.MethodName] = true
+		}
+	}
+	return methods
+}
+
+// idempotencyInterceptor sends a new idempotency key with each call of the
+// given methods which does not already carry one, so that the portal applies
+// the call only once however often it is retried.
+func idempotencyInterceptor(methods map[string]bool) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if methods[method] {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			if len(md.Get(idempotencyKeyMetadata)) == 0 {
+				ctx = metadata.AppendToOutgoingContext(ctx, idempotencyKeyMetadata, uuid.NewString())
+			}
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -62,6 +62,75 @@
     "CLAIM_STATE_OOEPAY_PAYMENT_TRIGGERED"   ()
     "CLAIM_STATE_DONE"                       ()))
 
+;; the claim history records every state transition of a claim, oldest first,
+;; separately from the claim itself so that it does not grow the claim data.
+(defun mk-claim-history-key (claim-id)
+  (join-index-cols "sandbox" "claim_history" claim-id))
+
+(defun claim-history (claim-id)
+  (default (sidedb:get (mk-claim-history-key claim-id)) (vector)))
+
+(defun tx-actor ()
+  ;; tx-actor returns the authenticated principal which made the request, as
+  ;; passed by the portal in the actor transient data.
+  (let* ([actor (cc:get-transient "actor")])
+    (when actor (to-string actor))))
+
+(defun record-claim-transition! (claim-id from-state to-state)
+  (let* ([desc (get claims-state-event-desc from-state)]
+         [transition (denil-map
+                       (sorted-map "from_state" from-state
+                                   "to_state"   to-state
+                                   "system"     (get desc "sys")
+                                   "msp"        (get desc "msp")
+                                   "timestamp"  (cc:timestamp (cc:now))
+                                   "actor"      (tx-actor)
+                                   "tx_id"      (cc:get-tx-id)))]
+         [history (claim-history claim-id)])
+    (sidedb:put (mk-claim-history-key claim-id)
+                (append! history transition))))
+
+;; the claim events record the connector events raised for a claim so that
+;; stuck claims can be debugged.  The event raised in a state is outstanding
+;; until the claim handles the connector response and moves on, at which
+;; point it is completed.
+(defun mk-claim-events-key (claim-id)
+  (join-index-cols "sandbox" "claim_events" claim-id))
+
+(defun claim-events (claim-id)
+  (default (sidedb:get (mk-claim-events-key claim-id)) (vector)))
+
+(defun record-claim-events! (claim-id state new-events)
+  ;; record-claim-events! completes the outstanding events of the claim and
+  ;; records the events raised leaving state.
+  (let* ([now (cc:timestamp (cc:now))]
+         [complete (lambda (event)
+                     (if (equal? (get event "status") "CLAIM_EVENT_STATUS_OUTSTANDING")
+                       (assoc (assoc event "status" "CLAIM_EVENT_STATUS_COMPLETED")
+                              "completed_at" now)
+                       event))]
+         [raise (lambda (event)
+                  (denil-map
+                    (sorted-map "oid"       (get event "oid")
+                                "key"       (get event "key")
+                                "pdc"       (get event "pdc")
+                                "msp"       (get event "msp")
+                                "sys"       (get event "sys")
+                                "eng"       (get event "eng")
+                                "req"       (get event "req")
+                                "state"     state
+                                "status"    "CLAIM_EVENT_STATUS_OUTSTANDING"
+                                "raised_at" now)))]
+         [events (concat 'vector
+                         (map 'vector complete (claim-events claim-id))
+                         (map 'vector raise new-events))])
+    (unless (empty? events)
+      (sidedb:put (mk-claim-events-key claim-id) events))))
+
+(defun claim-events-with-status (claim-id status)
+  (select (lambda (event) (equal? (get event "status") status))
+          (claim-events claim-id)))
+
 ;;
 ;; TODO: receive, validate, store, send
 ;;
@@ -70,6 +139,9 @@
   ;; For now, just returns a simple health check query
   (mk-psql-req "SELECT 1"))
 
+(defun claim-declined? (claim-data)
+  (equal? (get claim-data "status") "STATUS_DECLINED"))
+
 (defun mk-claim (claim)
   ;; mk-claim implements claims handler logic
   (unless claim (error 'missing-claim "missing claim"))
@@ -102,8 +174,11 @@
 
        ;; ret-save returns a map that the connector hub API can use to store 
        ;; new data for the object, and raise events for subsequent processing.
+       ;; The transition is recorded in the claim history.
        [ret-save ()
-                 (next-state)
+                 (let* ([from-state (get-state)])
+                   (record-claim-events! (id) from-state events)
+                   (record-claim-transition! (id) from-state (next-state)))
                  (sorted-map "put" claim "events" events)]
 
        [init ()
@@ -112,14 +187,27 @@
        
        [data () claim]
 
+       ;; halted? returns true if the claim was declined, which stops its
+       ;; workflow.
+       [halted? () (claim-declined? claim)]
+
+       ;; handle applies a connector response to the claim, unless its
+       ;; workflow was halted in which case late responses are dropped.
        [handle (resp)
+         (if (halted?)
+           (progn
+             (cc:infof (log-fields (sorted-map "claim_id" (id))) "claim declined, ignoring response")
+             (sorted-map "put" claim "events" events))
+           (advance resp))]
+
+       [advance (resp)
          (let* ([resp-body (get resp "response")]
                 [resp-err (get resp "error")]
                 [state (get-state)])
            (when resp-err 
              (set-exception-unexpected
                (format-string "unhandled response error: {}" resp-err)))
-           (cc:infof (assoc resp-body "state" state) "handle")
+           (cc:infof (log-fields (assoc resp-body "state" state)) "handle")
            (cond
              ((equal? state "CLAIM_STATE_LOECLAIM_DETAILS_COLLECTED")
               ;; equifax event does not have NATIONALITY prefix
@@ -166,6 +254,32 @@
               ((equal? op 'data) (apply data args))
               (:else (error 'unknown-operation op)))))))
 
+(set 'claim-index-max-scan 1000)
+(set 'claim-page-size-default 50)
+(set 'claim-page-size-max 500)
+
+(defun member? (x xs)
+  (not (empty? (select (lambda (y) (equal? x y)) xs))))
+
+(defun mk-claim-filter (req)
+  ;; mk-claim-filter returns a predicate that matches claim data against the
+  ;; optional filters in a list_claims request.
+  (let* ([states (get req "states")]
+         [statuses (get req "statuses")]
+         [from (get req "date_of_accident_from")]
+         [to (get req "date_of_accident_to")])
+    (lambda (claim)
+      (let* ([date (get claim "date_of_accident")])
+        (and (or (empty? states)
+                 (member? (get claim "state") states))
+             (or (empty? statuses)
+                 (member? (default (get claim "status") "STATUS_UNSPECIFIED")
+                          statuses))
+             (or (nil? from)
+                 (and date (not (string< date from))))
+             (or (nil? to)
+                 (and date (not (string> date to)))))))))
+
 ;; mk-claims implements connector factory
 (defun mk-claims ()
   (labels
@@ -174,12 +288,45 @@
      [mk-claim-storage-key (claim-id)
        (join-index-cols "sandbox" "claim"  claim-id)]
 
+     ;; every update of a claim increments its revision, which callers may
+     ;; check to detect concurrent updates.
      [storage-put-claim (claim)
+       (assoc! claim "revision" (+ 1 (default (get claim "revision") 0)))
        (sidedb:put (mk-claim-storage-key (get claim "claim_id")) claim)]
 
-     [new-claim () 
+     ;; the claim index records claim IDs in creation order so that claims
+     ;; can be listed without knowing their IDs up front.
+     [mk-index-count-key ()
+       (join-index-cols "sandbox" "claim_index" "count")]
+
+     [mk-index-key (n)
+       (join-index-cols "sandbox" "claim_index" (to-string n))]
+
+     [index-count () (default (sidedb:get (mk-index-count-key)) 0)]
+
+     [index-put (claim-id)
+       (let* ([n (index-count)])
+         (sidedb:put (mk-index-key n) claim-id)
+         (sidedb:put (mk-index-count-key) (+ n 1)))]
+
+     [index-get (n) (sidedb:get (mk-index-key n))]
+
+     ;; new-claim records any claim details supplied by the caller before the
+     ;; claim is first stored, so a new claim starts at revision 1.
+     [new-claim (&optional details)
        (let* ([claim-data (sorted-map "claim_id" (mk-uuid))]
-              [claim (mk-claim claim-data)]) 
+              [claim (mk-claim claim-data)])
+         (when details
+           (let* ([date-of-accident (get details "date_of_accident")]
+                  [damage-amount (get details "damage_amount")]
+                  [claim-reason (get details "claim_reason")])
+             (when date-of-accident
+               (assoc! claim-data "date_of_accident" date-of-accident))
+             (when damage-amount
+               (assoc! claim-data "damage_amount" damage-amount))
+             (when claim-reason
+               (assoc! claim-data "claim_reason" claim-reason))))
+         (index-put (get claim-data "claim_id"))
          (claim 'init))]
 
      [storage-get-claim (claim-id)
@@ -188,7 +335,28 @@
          (when claim-data (mk-claim claim-data)))]
  
      [storage-del-claim (claim-id)
-       (sidedb:del (mk-claim-storage-key claim-id))])
+       (sidedb:del (mk-claim-storage-key claim-id))]
+
+     ;; scan-claims scans the claim index from position start and returns up
+     ;; to limit claims matching pred, along with the position to resume
+     ;; from.  At most claim-index-max-scan entries are read per call so a
+     ;; sparse filter cannot exhaust the transaction.
+     [scan-claims (start limit pred)
+       (let* ([count (index-count)]
+              [stop (if (< count (+ start claim-index-max-scan))
+                      count
+                      (+ start claim-index-max-scan))]
+              [result (vector)]
+              [scan (lambda (n)
+                      (if (or (>= n stop) (>= (length result) limit))
+                        n
+                        (let* ([claim (storage-get-claim (index-get n))])
+                          (when (and claim (funcall pred (claim 'data)))
+                            (append! result (claim 'data)))
+                          (scan (+ n 1)))))]
+              [next (scan start)])
+         (sorted-map "claims" result
+                     "next_page_token" (when (< next count) (to-string next))))])
 
     (lambda (op &rest args)
         (cond ((equal? op 'name) (apply name args))
@@ -196,6 +364,7 @@
               ((equal? op 'get) (apply storage-get-claim args))
               ((equal? op 'del) (apply storage-del-claim args))
               ((equal? op 'put) (apply storage-put-claim args))
+              ((equal? op 'list) (apply scan-claims args))
               (:else (error 'unknown-operation op))))))
 
 (set 'claims (singleton mk-claims))
@@ -203,8 +372,102 @@
 (register-connector-factory claims)
 
 (defun trigger-claim (claim-id resp)
+  (let* ([claim (or (claims 'get claim-id)
+                    (set-exception-not-found
+                      (format-string "missing claim {}" claim-id) "claim"))])
+    (when (claim-declined? (claim 'data))
+      (set-exception-business
+        (format-string "claim {} was declined" claim-id))))
   (trigger-connector-object claims claim-id resp))
 
-(defun create-claim ()
-  ; create claim allocates storage for a new claim, sets the ID and state.
-  (new-connector-object claims))
+(defun check-claim-revision! (claim-id expected-revision)
+  ; check-claim-revision! raises a conflict unless the claim is at
+  ; expected-revision.  An empty or zero expected-revision skips the check.
+  (unless (or (nil? expected-revision)
+              (equal? (to-string expected-revision) "0"))
+    (let* ([claim (or (claims 'get claim-id)
+                      (set-exception-not-found
+                        (format-string "missing claim {}" claim-id) "claim"))]
+           [revision (default (get (claim 'data) "revision") 0)])
+      (unless (equal? (to-string revision) (to-string expected-revision))
+        (set-exception-conflict
+          (format-string "claim {} is at revision {}, not {}"
+                         claim-id revision expected-revision)
+          revision)))))
+
+(defun advance-claim (claim-id state response &optional expected-revision)
+  ; advance-claim submits the connector response for the claim's current
+  ; state, moving the claim to the next state in its workflow.  The caller
+  ; must name the current state so that stale or out-of-order responses are
+  ; rejected rather than applied to a later state.
+  (check-claim-revision! claim-id expected-revision)
+  (let* ([claim (or (claims 'get claim-id)
+                    (set-exception-not-found
+                      (format-string "missing claim {}" claim-id) "claim"))]
+         [current (get (claim 'data) "state")])
+    (cond
+      ((not (equal? state current))
+       (set-exception-business
+         (format-string "claim {} is in state {}, not {}" claim-id current state)))
+      ((equal? current "CLAIM_STATE_LOECLAIM_DETAILS_COLLECTED")
+       (set-exception-business
+         "claimant details must be added before the claim can advance"))
+      ((nil? (get state-transitions current))
+       (set-exception-business
+         (format-string "claim {} cannot advance from state {}" claim-id current))))
+    (trigger-claim claim-id (default response (sorted-map)))))
+
+(set 'final-statuses (vector "STATUS_DECLINED" "STATUS_PAID"))
+
+(set 'paid-states (vector "CLAIM_STATE_OOEPAY_PAYMENT_TRIGGERED"
+                          "CLAIM_STATE_DONE"))
+
+(defun set-claim-status (claim-id status reason &optional expected-revision)
+  ; set-claim-status records the final outcome of a claim.  Declined and paid
+  ; are final.  A claim may only be paid once payment has been triggered, and
+  ; may not be declined after that.
+  (check-claim-revision! claim-id expected-revision)
+  (let* ([claim (or (claims 'get claim-id)
+                    (set-exception-not-found
+                      (format-string "missing claim {}" claim-id) "claim"))]
+         [data (claim 'data)]
+         [current (default (get data "status") "STATUS_UNSPECIFIED")]
+         [paid-state? (member? (get data "state") paid-states)])
+    (cond
+      ((empty? reason)
+       (set-exception-business "missing reason"))
+      ((member? current final-statuses)
+       (set-exception-business
+         (format-string "claim {} is already {}" claim-id current)))
+      ((equal? status current)
+       (set-exception-business
+         (format-string "claim {} is already {}" claim-id current)))
+      ((and (equal? status "STATUS_PAID") (not paid-state?))
+       (set-exception-business
+         (format-string "claim {} cannot be paid before payment is triggered" claim-id)))
+      ((and (equal? status "STATUS_DECLINED") paid-state?)
+       (set-exception-business
+         (format-string "claim {} cannot be declined after payment is triggered" claim-id)))
+      ((not (member? status (vector "STATUS_APPROVED" "STATUS_DECLINED" "STATUS_PAID")))
+       (set-exception-business
+         (format-string "invalid status {}" status))))
+    (assoc! data "status" status)
+    (assoc! data "status_reason" reason)
+    (claims 'put data)
+    data))
+
+(defun create-claim (&optional details)
+  ; create claim allocates storage for a new claim, sets the ID and state, and
+  ; records any claim details supplied by the caller.
+  (new-connector-object claims details))
+
+(defun list-claims (page-token page-size filter)
+  ; list-claims returns a page of claims matching filter, starting at the
+  ; position encoded in page-token.
+  (let* ([start (if (nil? page-token) 0 (to-int page-token))]
+         [limit (cond ((or (nil? page-size) (<= page-size 0))
+                       claim-page-size-default)
+                      ((> page-size claim-page-size-max)
+                       claim-page-size-max)
+                      (:else page-size))])
+    (claims 'list start limit filter)))
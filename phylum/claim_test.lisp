@@ -33,6 +33,67 @@
        [got-claim (claims 'get claim-id)])
       (assert (not (nil? got-claim))))))
 
+(test "create-claim-details"
+  (let* ([claim (create-claim (sorted-map "date_of_accident" "2024-03-01"
+                                          "damage_amount"    "125000"
+                                          "claim_reason"     "Rear-ended at junction"))]
+         [got-claim ((claims 'get (get claim "claim_id")) 'data)])
+    (assert-equal "2024-03-01" (get got-claim "date_of_accident"))
+    (assert-equal "125000" (get got-claim "damage_amount"))
+    (assert-equal "Rear-ended at junction" (get got-claim "claim_reason"))
+    (assert-equal 1 (get got-claim "revision"))))
+
+;; every state transition is recorded in the claim history.
+(test "claim-history"
+  (let* ([claim (create-claim)]
+         [claim-id (get claim "claim_id")]
+         [history (claim-history claim-id)])
+    (assert-equal 1 (length history))
+    (assert-equal "CLAIM_STATE_NEW" (get (first history) "from_state"))
+    (assert-equal "CLAIM_STATE_LOECLAIM_DETAILS_COLLECTED"
+                  (get (first history) "to_state"))
+    (assert-equal "CLAIMS_PORTAL_UI" (get (first history) "system"))
+    (populate-test-claimant! claim)
+    (trigger-claim claim-id (get claim "claimant"))
+    (let* ([history (claim-history claim-id)])
+      (assert-equal 2 (length history))
+      (assert-equal "CLAIM_STATE_LOECLAIM_ID_VERIFIED"
+                    (get (get history 1) "to_state")))))
+
+;; connector events are outstanding until the claim moves on.
+(test "claim-events"
+  (let* ([claim (create-claim)]
+         [claim-id (get claim "claim_id")])
+    (assert-equal 0 (length (claim-events claim-id)))
+    (populate-test-claimant! claim)
+    (trigger-claim claim-id (get claim "claimant"))
+    (let* ([outstanding (claim-events-with-status
+                          claim-id "CLAIM_EVENT_STATUS_OUTSTANDING")])
+      (assert-equal 1 (length outstanding))
+      (assert-equal "EQUIFAX_ID_VERIFY" (get (first outstanding) "sys"))
+      (assert-equal "CLAIM_STATE_LOECLAIM_DETAILS_COLLECTED"
+                    (get (first outstanding) "state")))
+    (advance-claim claim-id "CLAIM_STATE_LOECLAIM_ID_VERIFIED" (sorted-map))
+    (let* ([completed (claim-events-with-status
+                        claim-id "CLAIM_EVENT_STATUS_COMPLETED")]
+           [outstanding (claim-events-with-status
+                          claim-id "CLAIM_EVENT_STATUS_OUTSTANDING")])
+      (assert-equal 1 (length completed))
+      (assert-equal "EQUIFAX_ID_VERIFY" (get (first completed) "sys"))
+      (assert-equal 1 (length outstanding))
+      (assert-equal "CAMUNDA_WORKFLOW" (get (first outstanding) "sys")))))
+
+;; every update increments the claim revision.
+(test "claim-revision"
+  (let* ([claim (create-claim)]
+         [claim-id (get claim "claim_id")]
+         [revision (get ((claims 'get claim-id) 'data) "revision")])
+    (assert (< 0 revision))
+    (populate-test-claimant! claim)
+    (trigger-claim claim-id (get claim "claimant"))
+    (assert-equal (+ 1 revision)
+                  (get ((claims 'get claim-id) 'data) "revision"))))
+
 (use-package 'connector)
 
 ;;
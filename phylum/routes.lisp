@@ -8,17 +8,60 @@
 ;; routes in an application.
 (in-package 'sandbox)
 
+;; return-exception handles a condition carrying an exception by aborting the
+;; transaction and returning the exception as the endpoint's response.
+(defun return-exception (_ exception)
+  (cc:force-no-commit-tx)
+  (route-success (sorted-map "exception" exception)))
+
 ;; wrap-endpoint is a simple wrapper for endpoints which allows them to call
-;; set-exception and shortcircuit the endpoint handler.  wrap-endpoint may be
-;; customised to add universal logging or book-keeping that should be present
-;; on every transaction.
+;; set-exception, or raise an exception with set-exception-conflict or
+;; set-exception-not-found, and shortcircuit the endpoint handler.
+;; wrap-endpoint may be customised to add universal logging or book-keeping
+;; that should be present on every transaction.
 (defun wrap-endpoint (route-handler)
-  (handler-bind ([set-exception-error
-                  (lambda (_ exception)
-                    (cc:force-no-commit-tx)
-                    (route-success (sorted-map "exception" exception)))])
+  (handler-bind ([set-exception-error return-exception]
+                 [revision-conflict return-exception]
+                 [not-found return-exception])
                 (funcall route-handler)))
 
+;; set-exception-conflict shortcircuits the endpoint handler with a business
+;; exception marked as a revision conflict, which the portal reports as a
+;; conflict rather than a bad request.
+(defun set-exception-conflict (msg revision)
+  (error 'revision-conflict
+         (sorted-map "id"          (mk-uuid)
+                     "type"        "BUSINESS"
+                     "timestamp"   (cc:timestamp (cc:now))
+                     "description" msg
+                     "exception_metadata"
+                     (sorted-map "conflict" "revision"
+                                 "revision" (to-string revision)))))
+
+;; set-exception-not-found shortcircuits the endpoint handler with a business
+;; exception marked as a missing resource, which the portal reports as not
+;; found rather than a bad request.
+(defun set-exception-not-found (msg resource)
+  (error 'not-found
+         (sorted-map "id"          (mk-uuid)
+                     "type"        "BUSINESS"
+                     "timestamp"   (cc:timestamp (cc:now))
+                     "description" msg
+                     "exception_metadata"
+                     (sorted-map "not_found" resource))))
+
+;; tx-request-id returns the ID of the request which the transaction serves,
+;; as passed by the portal in the request_id transient data.
+(defun tx-request-id ()
+  (let* ([id (cc:get-transient "request_id")])
+    (when id (to-string id))))
+
+;; log-fields adds the request ID, if any, to the fields of a log line so that
+;; phylum logs can be tied to the portal's logs of the request.
+(defun log-fields (fields)
+  (let* ([id (tx-request-id)])
+    (if id (assoc fields "request_id" id) fields)))
+
 ;; defendpoint shadows router:endpoint so that all endpoints can be wrapped
 ;; with logic contained in wrap-endpoint.
 (defmacro defendpoint (name args &rest exprs)
@@ -44,12 +87,12 @@
   (let* ([prev-version (statedb:get app-version-key)]
          [init? (nil? prev-version)])
     (if init?
-      (cc:infof (sorted-map "phylum_version" version
-                            "build_id" build-id)
+      (cc:infof (log-fields (sorted-map "phylum_version" version
+                                        "build_id" build-id))
                 "Phylum initialized")
-      (cc:infof (sorted-map "phylum_version" version
-                            "phylum_version_old" prev-version
-                            "build_id" build-id)
+      (cc:infof (log-fields (sorted-map "phylum_version" version
+                                        "phylum_version_old" prev-version
+                                        "build_id" build-id))
                 "Phylum upgraded"))
     (statedb:put app-version-key version)
     (route-success ())))
@@ -64,7 +107,7 @@
                           "timestamp"       (cc:timestamp (cc:now)))))))
 
 (defendpoint "create_claim" (req)
-  (route-success (sorted-map "claim" (create-claim))))
+  (route-success (sorted-map "claim" (create-claim req))))
 
 (defendpoint "add_claimant" (req)
   (let* ([claim-id (or (get req "claim_id")
@@ -72,13 +115,65 @@
          [claimant (get req "claimant")])
     (when (or (nil? claimant) (empty? (get claimant "forename")))
       (set-exception-business "missing claimant forename"))
+    (check-claim-revision! claim-id (get req "expected_revision"))
     (route-success (sorted-map "claim" (trigger-claim claim-id claimant)))))
 
+(defendpoint "set_claim_status" (req)
+  (let* ([claim-id (or (get req "claim_id")
+                       (set-exception-business "missing claim_id"))])
+    (route-success
+      (sorted-map "claim" (set-claim-status claim-id
+                                            (get req "status")
+                                            (get req "reason")
+                                            (get req "expected_revision"))))))
+
+(defendpoint "decline_claim" (req)
+  (let* ([claim-id (or (get req "claim_id")
+                       (set-exception-business "missing claim_id"))])
+    (route-success
+      (sorted-map "claim" (set-claim-status claim-id
+                                            "STATUS_DECLINED"
+                                            (get req "reason")
+                                            (get req "expected_revision"))))))
+
+(defendpoint "advance_claim" (req)
+  (let* ([claim-id (or (get req "claim_id")
+                       (set-exception-business "missing claim_id"))])
+    (route-success
+      (sorted-map "claim" (advance-claim claim-id
+                                         (get req "state")
+                                         (get req "response")
+                                         (get req "expected_revision"))))))
+
 (defendpoint-get "get_claim" (req)
   (let* ([claim-id (or (get req "claim_id")
                        (set-exception-business "missing claim_id"))]
          [claim (or (claims 'get claim-id)
-                    (set-exception-business 
-                      (format-string "missing claim {}" claim-id)))]
+                    (set-exception-not-found
+                      (format-string "missing claim {}" claim-id) "claim"))]
          [data (claim 'data)])
     (route-success (sorted-map "claim" data))))
+
+(defendpoint-get "get_claim_history" (req)
+  (let* ([claim-id (or (get req "claim_id")
+                       (set-exception-business "missing claim_id"))])
+    (unless (claims 'get claim-id)
+      (set-exception-not-found (format-string "missing claim {}" claim-id) "claim"))
+    (route-success (sorted-map "transitions" (claim-history claim-id)))))
+
+(defendpoint-get "list_claim_events" (req)
+  (let* ([claim-id (or (get req "claim_id")
+                       (set-exception-business "missing claim_id"))])
+    (unless (claims 'get claim-id)
+      (set-exception-not-found (format-string "missing claim {}" claim-id) "claim"))
+    (route-success
+      (sorted-map "outstanding" (claim-events-with-status
+                                  claim-id "CLAIM_EVENT_STATUS_OUTSTANDING")
+                  "completed" (claim-events-with-status
+                                claim-id "CLAIM_EVENT_STATUS_COMPLETED")))))
+
+(defendpoint-get "list_claims" (req)
+  (route-success
+    (list-claims (get req "page_token")
+                 (get req "page_size")
+                 (mk-claim-filter req))))
@@ -0,0 +1,221 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	common "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/common/v1"
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	"github.com/luthersystems/sandbox/client"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// claimCmd calls a running portal to create and inspect claims.  Commands
+// fail if the portal returns an exception.
+type claimCmd struct {
+	Create      claimCreateCmd      `cmd:"create" help:"Create a claim"`
+	Get         claimGetCmd         `cmd:"get" help:"Get a claim"`
+	AddClaimant claimAddClaimantCmd `cmd:"add-claimant" help:"Add the claimant to a claim"`
+	List        claimListCmd        `cmd:"list" help:"List claims"`
+}
+
+// claimOutputFlags configure how claim commands print claims.
+type claimOutputFlags struct {
+	Format string `help:"Output format" enum:"table,json" default:"table"`
+}
+
+// print writes the response of a command, or returns err.  If the format is
+// JSON the exception returned by the portal, if any, is also written as an
+// ExceptionResponse so that scripts can inspect it.
+func (f *claimOutputFlags) print(w io.Writer, m proto.Message, claims []*pb.Claim, err error) error {
+	if err != nil {
+		var se *client.StatusError
+		if f.Format == "json" && errors.As(err, &se) && se.Exception != nil {
+			_ = writeProtoJSON(w, &common.ExceptionResponse{Exception: se.Exception})
+		}
+		return err
+	}
+	if f.Format == "json" {
+		return writeProtoJSON(w, m)
+	}
+	return writeClaimTable(w, claims)
+}
+
+func writeProtoJSON(w io.Writer, m proto.Message) error {
+	b, err := protojson.MarshalOptions{Multiline: true}.Marshal(m)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(b))
+	return err
+}
+
+// writeClaimTable writes a row summarizing each claim.  Enum values are
+// printed without their prefix and damage amounts in units rather than
+// cents.
+func writeClaimTable(w io.Writer, claims []*pb.Claim) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "CLAIM ID\tSTATE\tSTATUS\tDATE OF ACCIDENT\tDAMAGE\tCLAIMANT\tREVISION")
+	for _, c := range claims {
+		status := ""
+		if c.GetStatus() != pb.Status_STATUS_UNSPECIFIED {
+			status = strings.TrimPrefix(c.GetStatus().String(), "STATUS_")
+		}
+		claimant := strings.TrimSpace(c.GetClaimant().GetForename() + " " + c.GetClaimant().GetSurname())
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d.%02d\t%s\t%d\n",
+			c.GetClaimId(),
+			strings.TrimPrefix(c.GetState().String(), "CLAIM_STATE_"),
+			status,
+			c.GetDateOfAccident(),
+			c.GetDamageAmount()/100, c.GetDamageAmount()%100,
+			claimant,
+			c.GetRevision())
+	}
+	return tw.Flush()
+}
+
+type claimCreateCmd struct {
+	baseCmd
+	connFlags
+	claimOutputFlags
+	DateOfAccident string `help:"Date of the accident (YYYY-MM-DD)"`
+	DamageAmount   int64  `help:"Damage amount in cents"`
+	ClaimReason    string `help:"Reason for the claim"`
+}
+
+func (r *claimCreateCmd) Run() error {
+	c, err := r.newClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	claim, err := c.CreateClaim(r.ctx, &pb.CreateClaimRequest{
+		DateOfAccident: r.DateOfAccident,
+		DamageAmount:   r.DamageAmount,
+		ClaimReason:    r.ClaimReason,
+	})
+	return r.print(os.Stdout, claim, []*pb.Claim{claim}, err)
+}
+
+type claimGetCmd struct {
+	baseCmd
+	connFlags
+	claimOutputFlags
+	ClaimID string `arg:"" help:"ID of the claim"`
+}
+
+func (r *claimGetCmd) Run() error {
+	c, err := r.newClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	claim, err := c.GetClaim(r.ctx, r.ClaimID)
+	return r.print(os.Stdout, claim, []*pb.Claim{claim}, err)
+}
+
+type claimAddClaimantCmd struct {
+	baseCmd
+	connFlags
+	claimOutputFlags
+	ClaimID          string `arg:"" help:"ID of the claim"`
+	Forename         string `help:"Forename of the claimant" required:""`
+	Surname          string `help:"Surname of the claimant" required:""`
+	Dob              string `help:"Date of birth (YYYY-MM-DD)"`
+	Nationality      string `help:"Nationality, e.g. GB"`
+	AccountNumber    string `help:"Bank account number"`
+	AccountSortCode  string `help:"Bank sort code"`
+	FullAddress      string `help:"Full address in a single line"`
+	AddressNumber    string `help:"House or building number"`
+	AddressStreet1   string `help:"Street address"`
+	AddressPostcode  string `help:"Postal code"`
+	AddressPostTown  string `help:"Post town or city"`
+	ExpectedRevision int64  `help:"Reject the update unless the claim is at this revision (0 to skip the check)"`
+}
+
+// claimant returns the claimant described by the flags.
+func (r *claimAddClaimantCmd) claimant() (*pb.Claimant, error) {
+	claimant := &pb.Claimant{
+		Forename:        r.Forename,
+		Surname:         r.Surname,
+		Dob:             r.Dob,
+		AccountNumber:   r.AccountNumber,
+		AccountSortCode: r.AccountSortCode,
+		FullAddress:     r.FullAddress,
+		AddressNumber:   r.AddressNumber,
+		AddressStreet1:  r.AddressStreet1,
+		AddressPostcode: r.AddressPostcode,
+		AddressPostTown: r.AddressPostTown,
+	}
+	if r.Nationality != "" {
+		v, err := parseEnumName(pb.Nationality_value, "NATIONALITY_", r.Nationality)
+		if err != nil {
+			return nil, fmt.Errorf("nationality: %w", err)
+		}
+		claimant.Nationality = pb.Nationality(v)
+	}
+	return claimant, nil
+}
+
+func (r *claimAddClaimantCmd) Run() error {
+	claimant, err := r.claimant()
+	if err != nil {
+		return err
+	}
+	c, err := r.newClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	claim, err := c.AddClaimant(r.ctx, &pb.AddClaimantRequest{
+		ClaimId:          r.ClaimID,
+		Claimant:         claimant,
+		ExpectedRevision: r.ExpectedRevision,
+	})
+	return r.print(os.Stdout, claim, []*pb.Claim{claim}, err)
+}
+
+type claimListCmd struct {
+	baseCmd
+	connFlags
+	claimOutputFlags
+	PageSize           int32    `help:"Maximum number of claims to list (0 for the portal default)"`
+	PageToken          string   `help:"Token of the page to list, from a previous listing"`
+	States             []string `name:"state" help:"Only list claims in this state, e.g. LOECLAIM_ID_VERIFIED (repeatable)"`
+	Statuses           []string `name:"status" help:"Only list claims with this final status, e.g. PAID (repeatable)"`
+	DateOfAccidentFrom string   `help:"Only list claims with an accident on or after this date (YYYY-MM-DD)"`
+	DateOfAccidentTo   string   `help:"Only list claims with an accident on or before this date (YYYY-MM-DD)"`
+}
+
+func (r *claimListCmd) Run() error {
+	filter, err := exportRequest(r.States, r.Statuses)
+	if err != nil {
+		return err
+	}
+	c, err := r.newClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	resp, err := c.ListClaims(r.ctx, &pb.ListClaimsRequest{
+		PageSize:           r.PageSize,
+		PageToken:          r.PageToken,
+		States:             filter.GetStates(),
+		Statuses:           filter.GetStatuses(),
+		DateOfAccidentFrom: r.DateOfAccidentFrom,
+		DateOfAccidentTo:   r.DateOfAccidentTo,
+	})
+	if err := r.print(os.Stdout, resp, resp.GetClaims(), err); err != nil {
+		return err
+	}
+	if r.Format == "table" && resp.GetNextPageToken() != "" {
+		fmt.Fprintf(os.Stderr, "more claims: --page-token %s\n", resp.GetNextPageToken())
+	}
+	return nil
+}
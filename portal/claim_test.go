@@ -0,0 +1,112 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+package main
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	common "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/common/v1"
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	srv "github.com/luthersystems/sandbox/api/srvpb/v1"
+	"github.com/luthersystems/sandbox/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestWriteClaimTable(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writeClaimTable(&buf, exportTestClaims()))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, []string{"CLAIM", "ID", "STATE", "STATUS", "DATE", "OF", "ACCIDENT", "DAMAGE", "CLAIMANT", "REVISION"}, strings.Fields(lines[0]))
+	assert.Equal(t, []string{"claim-1", "LOECLAIM_ID_VERIFIED", "2024-03-01", "1250.00", "Raymond", "Smith", "2"}, strings.Fields(lines[1]))
+	assert.Equal(t, []string{"claim-2", "LOECLAIM_DETAILS_COLLECTED", "DECLINED", "0.00", "1"}, strings.Fields(lines[2]))
+}
+
+func TestClaimPrint(t *testing.T) {
+	claim := exportTestClaims()[0]
+
+	var buf bytes.Buffer
+	f := &claimOutputFlags{Format: "json"}
+	require.NoError(t, f.print(&buf, claim, []*pb.Claim{claim}, nil))
+	var got pb.Claim
+	require.NoError(t, protojson.Unmarshal(buf.Bytes(), &got))
+	assert.True(t, proto.Equal(claim, &got))
+
+	buf.Reset()
+	ex := &common.Exception{Type: common.Exception_BUSINESS, Description: "missing claim"}
+	err := f.print(&buf, nil, nil, &client.BusinessError{StatusError: &client.StatusError{Exception: ex}})
+	var business *client.BusinessError
+	require.ErrorAs(t, err, &business)
+	var exResp common.ExceptionResponse
+	require.NoError(t, protojson.Unmarshal(buf.Bytes(), &exResp))
+	assert.Equal(t, "missing claim", exResp.GetException().GetDescription())
+
+	buf.Reset()
+	f.Format = "table"
+	require.Error(t, f.print(&buf, nil, nil, err))
+	assert.Empty(t, buf.String(), "tables are not written for exceptions")
+}
+
+func TestClaimant(t *testing.T) {
+	cmd := &claimAddClaimantCmd{Forename: "Ada", Surname: "Lovelace", Nationality: "gb"}
+	claimant, err := cmd.claimant()
+	require.NoError(t, err)
+	assert.Equal(t, pb.Nationality_NATIONALITY_GB, claimant.GetNationality())
+
+	cmd.Nationality = "atlantis"
+	_, err = cmd.claimant()
+	assert.ErrorContains(t, err, "nationality")
+}
+
+// fakeClaimService returns the claims it holds and a business exception for
+// any other claim.
+type fakeClaimService struct {
+	srv.UnimplementedSandboxServiceServer
+	claims map[string]*pb.Claim
+	apiKey []string
+}
+
+func (s *fakeClaimService) GetClaim(ctx context.Context, req *pb.GetClaimRequest) (*pb.GetClaimResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.apiKey = md.Get("x-api-key")
+	claim, ok := s.claims[req.GetClaimId()]
+	if !ok {
+		return &pb.GetClaimResponse{Result: &pb.GetClaimResponse_Exception{
+			Exception: &common.Exception{Type: common.Exception_BUSINESS, Description: "missing claim"},
+		}}, nil
+	}
+	return &pb.GetClaimResponse{Result: &pb.GetClaimResponse_Claim{Claim: claim}}, nil
+}
+
+func TestClaimGetCmd(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	svc := &fakeClaimService{claims: map[string]*pb.Claim{"claim-1": exportTestClaims()[0]}}
+	srv.RegisterSandboxServiceServer(server, svc)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	cmd := &claimGetCmd{
+		baseCmd:          baseCmd{ctx: context.Background()},
+		connFlags:        connFlags{Address: lis.Addr().String(), APIKey: "secret"},
+		claimOutputFlags: claimOutputFlags{Format: "table"},
+		ClaimID:          "claim-1",
+	}
+	require.NoError(t, cmd.Run())
+	assert.Equal(t, []string{"secret"}, svc.apiKey)
+
+	cmd.ClaimID = "missing"
+	err = cmd.Run()
+	var business *client.BusinessError
+	require.ErrorAs(t, err, &business, "exceptions fail the command")
+	assert.Equal(t, "missing claim", business.Exception.GetDescription())
+}
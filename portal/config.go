@@ -0,0 +1,200 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/alecthomas/kong"
+	"gopkg.in/yaml.v3"
+)
+
+// configFlag is the name of the flag giving the config file.
+const configFlag = "config"
+
+// redacted replaces the values of secret options in printed configuration.
+const redacted = "REDACTED"
+
+type configCmd struct {
+	Print configPrintCmd `cmd:"print" help:"Print the effective configuration of portal start, with secrets redacted"`
+}
+
+// configPrintCmd takes the same options as startCmd and prints their
+// effective values as a config file.
+type configPrintCmd struct {
+	baseCmd
+	startFlags
+}
+
+func (r *configPrintCmd) Run(kctx *kong.Context) error {
+	b, err := printConfig(kctx)
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(b)
+	return err
+}
+
+// BeforeResolve loads the config file, if any, so that its options are
+// resolved for flags not set on the command line or in the environment.
+func (f *startFlags) BeforeResolve(kctx *kong.Context) error {
+	var path string
+	for _, flag := range kctx.Flags() {
+		if flag.Name == configFlag {
+			path, _ = kctx.FlagValue(flag).(string)
+		}
+	}
+	if path == "" {
+		return nil
+	}
+	values, err := loadConfig(path)
+	if err != nil {
+		return fmt.Errorf("config %s: %w", path, err)
+	}
+	resolver, err := configResolver(kctx.Flags(), values)
+	if err != nil {
+		return fmt.Errorf("config %s: %w", path, err)
+	}
+	kctx.AddResolver(resolver)
+	return nil
+}
+
+// loadConfig reads a YAML or TOML config file, by its extension.
+func loadConfig(path string) (map[string]any, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]any)
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(b, &values)
+	case ".toml":
+		err = toml.Unmarshal(b, &values)
+	default:
+		return nil, fmt.Errorf("unsupported config format %q (want .yaml, .yml or .toml)", ext)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// configResolver resolves flags from the config file values, which are
+// keyed by flag name with either dashes or underscores.  Flags set by their
+// environment variable are left alone, as the environment takes precedence
+// over the file.
+func configResolver(flags []*kong.Flag, values map[string]any) (kong.Resolver, error) {
+	byName := make(map[string]any, len(values))
+	for k, v := range values {
+		byName[strings.ReplaceAll(k, "_", "-")] = v
+	}
+	known := make(map[string]bool, len(flags))
+	for _, flag := range flags {
+		known[flag.Name] = true
+	}
+	for name := range byName {
+		if !known[name] || name == configFlag {
+			return nil, fmt.Errorf("unknown option %q", name)
+		}
+	}
+	return kong.ResolverFunc(func(_ *kong.Context, _ *kong.Path, flag *kong.Flag) (any, error) {
+		if flagFromEnv(flag) != "" {
+			return nil, nil
+		}
+		v, ok := byName[flag.Name]
+		if !ok {
+			return nil, nil
+		}
+		return v, nil
+	}), nil
+}
+
+// flagFromEnv returns the environment variable setting the flag, if any.
+func flagFromEnv(flag *kong.Flag) string {
+	for _, env := range flag.Envs {
+		if _, ok := os.LookupEnv(env); ok {
+			return env
+		}
+	}
+	return ""
+}
+
+// printConfig returns the parsed options of the selected command as YAML,
+// commenting where each value came from.  Options tagged redact have their
+// values replaced.
+func printConfig(kctx *kong.Context) ([]byte, error) {
+	sources := make(map[*kong.Flag]string)
+	for _, trace := range kctx.Path {
+		switch {
+		case trace.Flag == nil:
+		case trace.Resolved:
+			sources[trace.Flag] = "config file"
+		default:
+			sources[trace.Flag] = "flag"
+		}
+	}
+	doc := &yaml.Node{Kind: yaml.MappingNode}
+	for _, flag := range kctx.Selected().Flags {
+		if flag.Hidden || flag.Name == configFlag {
+			continue
+		}
+		source := sources[flag]
+		if source == "" {
+			if env := flagFromEnv(flag); env != "" {
+				source = "env " + env
+			} else {
+				source = "default"
+			}
+		}
+		var value yaml.Node
+		if err := value.Encode(configValue(flag)); err != nil {
+			return nil, fmt.Errorf("%s: %w", flag.Name, err)
+		}
+		key := &yaml.Node{Kind: yaml.ScalarNode, Value: flag.Name}
+		if value.Kind == yaml.ScalarNode {
+			value.LineComment = source
+		} else {
+			key.LineComment = source
+		}
+		doc.Content = append(doc.Content, key, &value)
+	}
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// configValue returns the value of a flag as it is written in a config
+// file.
+func configValue(flag *kong.Flag) any {
+	v := flag.Target.Interface()
+	switch v := v.(type) {
+	case time.Duration:
+		return v.String()
+	case map[string]string:
+		if flag.Tag.Has("redact") {
+			m := make(map[string]string, len(v))
+			for k := range v {
+				m[k] = redacted
+			}
+			return m
+		}
+	case string:
+		if flag.Tag.Has("redact") && v != "" {
+			return redacted
+		}
+	}
+	return v
+}
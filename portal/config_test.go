@@ -0,0 +1,101 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/kong"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfig(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func parseCLI(t *testing.T, args ...string) (*cli, *kong.Context, error) {
+	t.Helper()
+	c := &cli{}
+	parser, err := kong.New(c)
+	require.NoError(t, err)
+	kctx, err := parser.Parse(args)
+	return c, kctx, err
+}
+
+func TestStartConfigPrecedence(t *testing.T) {
+	path := writeConfig(t, "portal.yaml", `
+listen-address: ":9000"
+gateway_endpoint: http://gateway:8082
+verbose: true
+idempotency-ttl: 1h
+api-keys:
+  ci: secret
+`)
+	t.Setenv("SANDBOX_ORACLE_GATEWAY_ENDPOINT", "http://env:8082")
+
+	c, _, err := parseCLI(t, "start", "--config", path, "--verbose=false")
+	require.NoError(t, err)
+	start := c.Start.startFlags
+	assert.Equal(t, ":9000", start.ListenAddress, "file over default")
+	assert.Equal(t, "http://env:8082", start.GatewayEndpoint, "env over file")
+	assert.False(t, start.Verbose, "flag over file")
+	assert.Equal(t, time.Hour, start.IdempotencyTTL)
+	assert.Equal(t, map[string]string{"ci": "secret"}, start.APIKeys)
+	assert.Equal(t, "roles", start.JWTRolesClaim, "defaults are kept")
+}
+
+func TestStartConfigTOML(t *testing.T) {
+	path := writeConfig(t, "portal.toml", `
+listen_address = ":9100"
+jwt-clock-skew = "2m"
+
+[api-keys]
+ci = "secret"
+`)
+	t.Setenv("SANDBOX_ORACLE_CONFIG", path)
+	c, _, err := parseCLI(t, "start")
+	require.NoError(t, err)
+	assert.Equal(t, ":9100", c.Start.ListenAddress)
+	assert.Equal(t, 2*time.Minute, c.Start.JWTClockSkew)
+	assert.Equal(t, map[string]string{"ci": "secret"}, c.Start.APIKeys)
+}
+
+func TestStartConfigErrors(t *testing.T) {
+	_, _, err := parseCLI(t, "start", "--config", writeConfig(t, "portal.yaml", "listen-adress: :9000\n"))
+	assert.ErrorContains(t, err, `unknown option "listen-adress"`)
+
+	_, _, err = parseCLI(t, "start", "--config", writeConfig(t, "portal.json", "{}"))
+	assert.ErrorContains(t, err, "unsupported config format")
+
+	_, _, err = parseCLI(t, "start", "--config", writeConfig(t, "portal.yaml", "config: other.yaml\n"))
+	assert.ErrorContains(t, err, `unknown option "config"`)
+}
+
+func TestPrintConfig(t *testing.T) {
+	path := writeConfig(t, "portal.yaml", `
+admin-key: hunter2
+api-keys:
+  ci: secret
+snapshot-interval: 30s
+`)
+	t.Setenv("SANDBOX_ORACLE_PHYLUM_PATH", "/phylum")
+	_, kctx, err := parseCLI(t, "config", "print", "--config", path, "-l", ":7000")
+	require.NoError(t, err)
+	b, err := printConfig(kctx)
+	require.NoError(t, err)
+	out := string(b)
+	assert.Contains(t, out, "listen-address: :7000 # flag\n")
+	assert.Contains(t, out, "phylum-path: /phylum # env SANDBOX_ORACLE_PHYLUM_PATH\n")
+	assert.Contains(t, out, "snapshot-interval: 30s # config file\n")
+	assert.Contains(t, out, "jwt-roles-claim: roles # default\n")
+	assert.Contains(t, out, "admin-key: REDACTED # config file\n")
+	assert.Contains(t, out, "api-keys: # config file\n  ci: REDACTED\n")
+	assert.NotContains(t, out, "hunter2")
+	assert.NotContains(t, out, "secret")
+}
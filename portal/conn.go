@@ -0,0 +1,40 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+package main
+
+import (
+	srv "github.com/luthersystems/sandbox/api/srvpb/v1"
+	"github.com/luthersystems/sandbox/client"
+	"google.golang.org/grpc"
+)
+
+// connFlags configure the connection of commands which call a running
+// portal's gRPC API.
+type connFlags struct {
+	Address string `help:"Address of the portal gRPC API" default:"localhost:9090" env:"SANDBOX_PORTAL_ADDRESS"`
+	APIKey  string `help:"API key sent with each request" env:"SANDBOX_PORTAL_API_KEY"`
+}
+
+// dial connects to the portal.  The returned function closes the
+// connection.
+func (c *connFlags) dial() (srv.SandboxServiceClient, func() error, error) {
+	cl, err := c.newClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	return cl.Service(), cl.Close, nil
+}
+
+// dialConn connects to the portal, for clients of any of its services.
+func (c *connFlags) dialConn() (*grpc.ClientConn, error) {
+	cl, err := c.newClient()
+	if err != nil {
+		return nil, err
+	}
+	return cl.Conn(), nil
+}
+
+// newClient returns a client for the portal, which converts exceptions to
+// typed errors.
+func (c *connFlags) newClient() (*client.Client, error) {
+	return client.New(c.Address, client.WithInsecure(), client.WithAPIKey(c.APIKey))
+}
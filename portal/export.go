@@ -0,0 +1,415 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	srv "github.com/luthersystems/sandbox/api/srvpb/v1"
+	"github.com/parquet-go/parquet-go"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// exportCmd writes every claim, optionally filtered, to a file.  Fields are
+// named as in the JSON API, with the fields of nested messages such as the
+// claimant prefixed by the name of the message field in CSV headers.
+type exportCmd struct {
+	baseCmd
+	connFlags
+	Format   string   `help:"Format of the export" enum:"ndjson,csv,parquet" default:"ndjson"`
+	Output   string   `short:"o" help:"File to write the export to (- for stdout)" default:"-"`
+	States   []string `name:"state" help:"Only export claims in this state, e.g. LOECLAIM_ID_VERIFIED (repeatable)"`
+	Statuses []string `name:"status" help:"Only export claims with this final status, e.g. PAID (repeatable)"`
+}
+
+func (r *exportCmd) Run() (err error) {
+	req, err := exportRequest(r.States, r.Statuses)
+	if err != nil {
+		return err
+	}
+	out := os.Stdout
+	if r.Output != "-" {
+		f, err := os.Create(r.Output)
+		if err != nil {
+			return err
+		}
+		defer func() { err = errors.Join(err, f.Close()) }()
+		out = f
+	}
+	client, closeConn, err := r.dial()
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+	w, err := newClaimWriter(r.Format, out)
+	if err != nil {
+		return err
+	}
+	n, err := exportClaims(r.ctx, client, req, w)
+	if err != nil {
+		return err
+	}
+	if r.Output != "-" {
+		fmt.Fprintf(os.Stderr, "exported %d claims to %s\n", n, r.Output)
+	}
+	return nil
+}
+
+// exportRequest builds the request filtering claims by the given state and
+// status names.  Names may omit the enum prefix, e.g. PAID for STATUS_PAID.
+func exportRequest(states, statuses []string) (*pb.ExportClaimsRequest, error) {
+	req := &pb.ExportClaimsRequest{}
+	for _, s := range states {
+		v, err := parseEnumName(pb.ClaimState_value, "CLAIM_STATE_", s)
+		if err != nil {
+			return nil, fmt.Errorf("state: %w", err)
+		}
+		req.States = append(req.States, pb.ClaimState(v))
+	}
+	for _, s := range statuses {
+		v, err := parseEnumName(pb.Status_value, "STATUS_", s)
+		if err != nil {
+			return nil, fmt.Errorf("status: %w", err)
+		}
+		req.Statuses = append(req.Statuses, pb.Status(v))
+	}
+	return req, nil
+}
+
+func parseEnumName(values map[string]int32, prefix, name string) (int32, error) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	if v, ok := values[name]; ok && v != 0 {
+		return v, nil
+	}
+	if v, ok := values[prefix+name]; ok && v != 0 {
+		return v, nil
+	}
+	return 0, fmt.Errorf("unknown value %q", name)
+}
+
+// exportClaims writes the claims streamed by the portal to w, returning the
+// number of claims written.
+func exportClaims(ctx context.Context, client srv.SandboxServiceClient, req *pb.ExportClaimsRequest, w claimWriter) (int, error) {
+	stream, err := client.ExportClaims(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			if ex := exceptionFromError(err); ex != nil {
+				return n, fmt.Errorf("export: %s", ex.GetDescription())
+			}
+			return n, err
+		}
+		if ex := resp.GetException(); ex != nil {
+			return n, fmt.Errorf("export: %s", ex.GetDescription())
+		}
+		if err := w.write(resp.GetClaim()); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, w.close()
+}
+
+// claimWriter writes exported claims in a file format.
+type claimWriter interface {
+	write(*pb.Claim) error
+	// close flushes the claims written.  It does not close the underlying
+	// writer.
+	close() error
+}
+
+// newClaimWriter returns a writer of claims to w in format.
+func newClaimWriter(format string, w io.Writer) (claimWriter, error) {
+	switch format {
+	case "csv":
+		return newCSVClaimWriter(w)
+	case "parquet":
+		return newParquetClaimWriter(w), nil
+	}
+	return newNDJSONClaimWriter(w), nil
+}
+
+// ndjsonClaimWriter writes each claim as a line of protojson.
+type ndjsonClaimWriter struct {
+	w   io.Writer
+	buf bytes.Buffer
+}
+
+func newNDJSONClaimWriter(w io.Writer) *ndjsonClaimWriter {
+	return &ndjsonClaimWriter{w: w}
+}
+
+func (w *ndjsonClaimWriter) write(claim *pb.Claim) error {
+	b, err := protojson.Marshal(claim)
+	if err != nil {
+		return err
+	}
+	// protojson output is not stable, so compact it to one line.
+	w.buf.Reset()
+	if err := json.Compact(&w.buf, b); err != nil {
+		return err
+	}
+	w.buf.WriteByte('\n')
+	_, err = w.w.Write(w.buf.Bytes())
+	return err
+}
+
+func (w *ndjsonClaimWriter) close() error {
+	return nil
+}
+
+// claimFields returns the fields of each column of a claim export: the
+// claim's scalar fields and, following the path from the claim, the fields
+// of its nested messages.
+func claimFields() [][]protoreflect.FieldDescriptor {
+	var paths [][]protoreflect.FieldDescriptor
+	var walk func(prefix []protoreflect.FieldDescriptor, fields protoreflect.FieldDescriptors)
+	walk = func(prefix []protoreflect.FieldDescriptor, fields protoreflect.FieldDescriptors) {
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			path := append(slices.Clip(prefix), fd)
+			if fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() {
+				walk(path, fd.Message().Fields())
+				continue
+			}
+			paths = append(paths, path)
+		}
+	}
+	walk(nil, (&pb.Claim{}).ProtoReflect().Descriptor().Fields())
+	return paths
+}
+
+// claimColumns returns the names of the columns of a claim export, which are
+// the JSON names of the fields of claimFields joined by ".".
+func claimColumns() []string {
+	var cols []string
+	for _, path := range claimFields() {
+		cols = append(cols, columnName(path))
+	}
+	return cols
+}
+
+func columnName(path []protoreflect.FieldDescriptor) string {
+	names := make([]string, len(path))
+	for i, fd := range path {
+		names[i] = fd.JSONName()
+	}
+	return strings.Join(names, ".")
+}
+
+// csvClaimWriter writes a header row of claimColumns followed by a row for
+// each claim, with values formatted as in protojson.
+type csvClaimWriter struct {
+	w    *csv.Writer
+	cols []string
+}
+
+func newCSVClaimWriter(w io.Writer) (*csvClaimWriter, error) {
+	cw := &csvClaimWriter{w: csv.NewWriter(w), cols: claimColumns()}
+	if err := cw.w.Write(cw.cols); err != nil {
+		return nil, err
+	}
+	return cw, nil
+}
+
+func (w *csvClaimWriter) write(claim *pb.Claim) error {
+	b, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(claim)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var fields map[string]any
+	if err := dec.Decode(&fields); err != nil {
+		return err
+	}
+	row := make([]string, len(w.cols))
+	for i, col := range w.cols {
+		row[i] = csvValue(fields, col)
+	}
+	return w.w.Write(row)
+}
+
+// csvValue returns the value at the dotted path in fields, or "" if it is
+// not set.
+func csvValue(fields map[string]any, path string) string {
+	var v any = fields
+	for _, name := range strings.Split(path, ".") {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return ""
+		}
+		v = m[name]
+	}
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+func (w *csvClaimWriter) close() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+// parquetClaimSchema returns the schema of a parquet claim export, which has
+// a column for each of claimColumns.  Nested messages, such as the claimant,
+// are optional groups.
+func parquetClaimSchema() *parquet.Schema {
+	return parquet.NewSchema("claim", parquetGroup(claimFields(), 0))
+}
+
+// parquetGroup returns the group of the columns at paths, which share their
+// first depth fields.
+func parquetGroup(paths [][]protoreflect.FieldDescriptor, depth int) parquet.Group {
+	group := parquet.Group{}
+	nested := make(map[string][][]protoreflect.FieldDescriptor)
+	for _, path := range paths {
+		name := path[depth].JSONName()
+		if len(path) == depth+1 {
+			group[name] = parquetLeaf(path[depth])
+			continue
+		}
+		nested[name] = append(nested[name], path)
+	}
+	for name, paths := range nested {
+		group[name] = parquet.Optional(parquetGroup(paths, depth+1))
+	}
+	return group
+}
+
+// parquetLeaf returns the column type of fd.  Enums are written by name, and
+// repeated fields and maps as JSON.
+func parquetLeaf(fd protoreflect.FieldDescriptor) parquet.Node {
+	if fd.IsList() || fd.IsMap() {
+		return parquet.String()
+	}
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return parquet.Leaf(parquet.BooleanType)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return parquet.Int(32)
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return parquet.Int(64)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return parquet.Uint(32)
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return parquet.Uint(64)
+	case protoreflect.FloatKind:
+		return parquet.Leaf(parquet.FloatType)
+	case protoreflect.DoubleKind:
+		return parquet.Leaf(parquet.DoubleType)
+	case protoreflect.BytesKind:
+		return parquet.Leaf(parquet.ByteArrayType)
+	}
+	return parquet.String()
+}
+
+// parquetValue returns the value of fd in m, typed as parquetLeaf.
+func parquetValue(m protoreflect.Message, fd protoreflect.FieldDescriptor) (parquet.Value, error) {
+	if fd.IsList() || fd.IsMap() {
+		b, err := protojson.Marshal(m.Interface())
+		if err != nil {
+			return parquet.Value{}, err
+		}
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(b, &fields); err != nil {
+			return parquet.Value{}, err
+		}
+		return parquet.ByteArrayValue(fields[fd.JSONName()]), nil
+	}
+	v := m.Get(fd)
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return parquet.BooleanValue(v.Bool()), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return parquet.Int32Value(int32(v.Int())), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return parquet.Int64Value(v.Int()), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return parquet.Int32Value(int32(v.Uint())), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return parquet.Int64Value(int64(v.Uint())), nil
+	case protoreflect.FloatKind:
+		return parquet.FloatValue(float32(v.Float())), nil
+	case protoreflect.DoubleKind:
+		return parquet.DoubleValue(v.Float()), nil
+	case protoreflect.BytesKind:
+		return parquet.ByteArrayValue(v.Bytes()), nil
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return parquet.ByteArrayValue([]byte(ev.Name())), nil
+		}
+		return parquet.ByteArrayValue([]byte(strconv.Itoa(int(v.Enum())))), nil
+	}
+	return parquet.ByteArrayValue([]byte(v.String())), nil
+}
+
+// parquetClaimWriter writes claims as rows of a parquet file.
+type parquetClaimWriter struct {
+	w *parquet.Writer
+	// fields are the fields of each column of the schema, in order.
+	fields [][]protoreflect.FieldDescriptor
+}
+
+func newParquetClaimWriter(w io.Writer) *parquetClaimWriter {
+	schema := parquetClaimSchema()
+	byName := make(map[string][]protoreflect.FieldDescriptor)
+	for _, path := range claimFields() {
+		byName[columnName(path)] = path
+	}
+	pw := &parquetClaimWriter{w: parquet.NewWriter(w, schema)}
+	for _, col := range schema.Columns() {
+		pw.fields = append(pw.fields, byName[strings.Join(col, ".")])
+	}
+	return pw
+}
+
+func (w *parquetClaimWriter) write(claim *pb.Claim) error {
+	row := make(parquet.Row, 0, len(w.fields))
+	for i, path := range w.fields {
+		value, err := parquetColumnValue(claim.ProtoReflect(), path)
+		if err != nil {
+			return err
+		}
+		row = append(row, value.Level(0, value.DefinitionLevel(), i))
+	}
+	_, err := w.w.WriteRows([]parquet.Row{row})
+	return err
+}
+
+// parquetColumnValue returns the value of the column at path in m.  Its
+// definition level counts the nested messages set along the path; the
+// value is null if one is not.
+func parquetColumnValue(m protoreflect.Message, path []protoreflect.FieldDescriptor) (parquet.Value, error) {
+	for depth, fd := range path[:len(path)-1] {
+		if !m.Has(fd) {
+			return parquet.Value{}.Level(0, depth, 0), nil
+		}
+		m = m.Get(fd).Message()
+	}
+	value, err := parquetValue(m, path[len(path)-1])
+	return value.Level(0, len(path)-1, 0), err
+}
+
+func (w *parquetClaimWriter) close() error {
+	return w.w.Close()
+}
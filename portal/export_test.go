@@ -0,0 +1,179 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"io"
+	"strings"
+	"testing"
+
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	srv "github.com/luthersystems/sandbox/api/srvpb/v1"
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeExportClient streams claims, or fails with err once they are sent.
+type fakeExportClient struct {
+	srv.SandboxServiceClient
+	claims []*pb.Claim
+	err    error
+	req    *pb.ExportClaimsRequest
+}
+
+func (c *fakeExportClient) ExportClaims(_ context.Context, req *pb.ExportClaimsRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[pb.ExportClaimsResponse], error) {
+	c.req = req
+	return &fakeExportStream{claims: c.claims, err: c.err}, nil
+}
+
+type fakeExportStream struct {
+	grpc.ClientStream
+	claims []*pb.Claim
+	err    error
+}
+
+func (s *fakeExportStream) Recv() (*pb.ExportClaimsResponse, error) {
+	if len(s.claims) == 0 {
+		if s.err != nil {
+			return nil, s.err
+		}
+		return nil, io.EOF
+	}
+	claim := s.claims[0]
+	s.claims = s.claims[1:]
+	return &pb.ExportClaimsResponse{Claim: claim}, nil
+}
+
+func exportTestClaims() []*pb.Claim {
+	return []*pb.Claim{
+		{
+			ClaimId:        "claim-1",
+			State:          pb.ClaimState_CLAIM_STATE_LOECLAIM_ID_VERIFIED,
+			DateOfAccident: "2024-03-01",
+			DamageAmount:   125000,
+			ClaimReason:    "Rear-ended, at junction",
+			Revision:       2,
+			Claimant: &pb.Claimant{
+				Forename:    "Raymond",
+				Surname:     "Smith",
+				Nationality: pb.Nationality_NATIONALITY_GB,
+			},
+		},
+		{
+			ClaimId:      "claim-2",
+			State:        pb.ClaimState_CLAIM_STATE_LOECLAIM_DETAILS_COLLECTED,
+			Status:       pb.Status_STATUS_DECLINED,
+			StatusReason: "Duplicate",
+			Revision:     1,
+		},
+	}
+}
+
+func TestExportRequest(t *testing.T) {
+	req, err := exportRequest([]string{"loeclaim_id_verified", "CLAIM_STATE_DONE"}, []string{"PAID"})
+	require.NoError(t, err)
+	assert.Equal(t, []pb.ClaimState{pb.ClaimState_CLAIM_STATE_LOECLAIM_ID_VERIFIED, pb.ClaimState_CLAIM_STATE_DONE}, req.GetStates())
+	assert.Equal(t, []pb.Status{pb.Status_STATUS_PAID}, req.GetStatuses())
+
+	_, err = exportRequest([]string{"BOGUS"}, nil)
+	assert.ErrorContains(t, err, "state")
+	_, err = exportRequest(nil, []string{"UNSPECIFIED"})
+	assert.ErrorContains(t, err, "status")
+}
+
+func TestExportNDJSON(t *testing.T) {
+	client := &fakeExportClient{claims: exportTestClaims()}
+	req := &pb.ExportClaimsRequest{Statuses: []pb.Status{pb.Status_STATUS_DECLINED}}
+	var out bytes.Buffer
+	n, err := exportClaims(context.Background(), client, req, newNDJSONClaimWriter(&out))
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, req, client.req)
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"claimId":"claim-1"`)
+	assert.Contains(t, lines[0], `"damageAmount":"125000"`)
+	assert.Contains(t, lines[0], `"claimant":{`)
+	assert.Contains(t, lines[1], `"status":"STATUS_DECLINED"`)
+}
+
+func TestExportCSV(t *testing.T) {
+	var out bytes.Buffer
+	w, err := newCSVClaimWriter(&out)
+	require.NoError(t, err)
+	_, err = exportClaims(context.Background(), &fakeExportClient{claims: exportTestClaims()}, &pb.ExportClaimsRequest{}, w)
+	require.NoError(t, err)
+
+	rows, err := csv.NewReader(&out).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	header := rows[0]
+	assert.Equal(t, claimColumns(), header)
+	row := func(i int) map[string]string {
+		m := make(map[string]string)
+		for j, col := range header {
+			m[col] = rows[i][j]
+		}
+		return m
+	}
+	assert.Equal(t, "claim-1", row(1)["claimId"])
+	assert.Equal(t, "Rear-ended, at junction", row(1)["claimReason"])
+	assert.Equal(t, "125000", row(1)["damageAmount"])
+	assert.Equal(t, "Raymond", row(1)["claimant.forename"])
+	assert.Equal(t, "NATIONALITY_GB", row(1)["claimant.nationality"])
+	assert.Equal(t, "", row(2)["claimant.forename"])
+	assert.Equal(t, "STATUS_DECLINED", row(2)["status"])
+}
+
+// parquetTestClaim reads some columns of a parquet claim export.
+type parquetTestClaim struct {
+	ClaimID      string `parquet:"claimId"`
+	DamageAmount int64  `parquet:"damageAmount"`
+	Status       string `parquet:"status"`
+	Claimant     *struct {
+		Forename    string `parquet:"forename"`
+		Nationality string `parquet:"nationality"`
+	} `parquet:"claimant,optional"`
+}
+
+func TestExportParquet(t *testing.T) {
+	var out bytes.Buffer
+	_, err := exportClaims(context.Background(), &fakeExportClient{claims: exportTestClaims()}, &pb.ExportClaimsRequest{}, newParquetClaimWriter(&out))
+	require.NoError(t, err)
+
+	rows, err := parquet.Read[parquetTestClaim](bytes.NewReader(out.Bytes()), int64(out.Len()))
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, "claim-1", rows[0].ClaimID)
+	assert.Equal(t, int64(125000), rows[0].DamageAmount)
+	require.NotNil(t, rows[0].Claimant)
+	assert.Equal(t, "Raymond", rows[0].Claimant.Forename)
+	assert.Equal(t, "NATIONALITY_GB", rows[0].Claimant.Nationality)
+	assert.Nil(t, rows[1].Claimant)
+	assert.Equal(t, "STATUS_DECLINED", rows[1].Status)
+}
+
+func TestParquetClaimColumns(t *testing.T) {
+	var cols []string
+	for _, path := range parquetClaimSchema().Columns() {
+		cols = append(cols, strings.Join(path, "."))
+	}
+	assert.ElementsMatch(t, claimColumns(), cols, "the parquet schema has a column for each claim field")
+}
+
+func TestExportError(t *testing.T) {
+	client := &fakeExportClient{
+		claims: exportTestClaims()[:1],
+		err:    status.Error(codes.PermissionDenied, "forbidden"),
+	}
+	var out bytes.Buffer
+	n, err := exportClaims(context.Background(), client, &pb.ExportClaimsRequest{}, newNDJSONClaimWriter(&out))
+	assert.Equal(t, 1, n)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
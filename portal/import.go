@@ -0,0 +1,383 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	common "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/common/v1"
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	srv "github.com/luthersystems/sandbox/api/srvpb/v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// importCmd creates claims from a file of legacy records.  Each record is a
+// flat set of fields named after the fields of CreateClaimRequest and
+// Claimant, e.g. date_of_accident, damage_amount, forename, nationality.
+// A claim is created for each record, and the claimant is added to it if
+// any claimant fields are present.
+type importCmd struct {
+	baseCmd
+	connFlags
+	File        string `arg:"" help:"CSV or NDJSON file of claims (- for stdin)"`
+	Format      string `help:"Format of the file (auto detects from the file extension)" enum:"auto,csv,ndjson" default:"auto"`
+	Concurrency int    `help:"Maximum number of claims imported at once" default:"8"`
+	Report      string `help:"File to write the NDJSON per-record report to (- for stdout)" default:"-"`
+}
+
+func (r *importCmd) Run() error {
+	read, closeFile, err := openImportFile(r.File, r.Format)
+	if err != nil {
+		return err
+	}
+	defer closeFile()
+	out := os.Stdout
+	if r.Report != "-" {
+		f, err := os.Create(r.Report)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	client, closeConn, err := r.dial()
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+	return importClaims(r.ctx, client, read, r.Concurrency, out)
+}
+
+// openImportFile opens file, or stdin if file is "-", and returns a function
+// reading its records in format.  The returned function closes the file.
+func openImportFile(file, format string) (func(emit func(importRecord) error) error, func() error, error) {
+	format, err := importFormat(file, format)
+	if err != nil {
+		return nil, nil, err
+	}
+	in := os.Stdin
+	if file != "-" {
+		in, err = os.Open(file)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	read := readCSVClaims
+	if format == "ndjson" {
+		read = readNDJSONClaims
+	}
+	return func(emit func(importRecord) error) error {
+		return read(in, emit)
+	}, in.Close, nil
+}
+
+// importFormat returns the format of file, detecting it from the file
+// extension if format is "auto".
+func importFormat(file, format string) (string, error) {
+	if format != "auto" {
+		return format, nil
+	}
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".csv":
+		return "csv", nil
+	case ".ndjson", ".jsonl", ".json":
+		return "ndjson", nil
+	}
+	return "", fmt.Errorf("cannot detect the format of %s: use --format", file)
+}
+
+// importRecord is a claim read from an import file.
+type importRecord struct {
+	// line is the line of the file on which the record starts.
+	line     int
+	claim    *pb.CreateClaimRequest
+	claimant *pb.Claimant
+	// err is set if the record could not be mapped to a claim.
+	err error
+}
+
+// importResult reports the outcome of importing a record.
+type importResult struct {
+	Line      int             `json:"line"`
+	ClaimID   string          `json:"claim_id,omitempty"`
+	Exception json.RawMessage `json:"exception,omitempty"`
+	Error     string          `json:"error,omitempty"`
+}
+
+func (r *importResult) failed() bool {
+	return r.Exception != nil || r.Error != ""
+}
+
+// setError records err, preferring the exception it carries.
+func (r *importResult) setError(err error) {
+	if ex := exceptionFromError(err); ex != nil {
+		r.setException(ex)
+		return
+	}
+	r.Error = err.Error()
+}
+
+func (r *importResult) setException(ex *common.Exception) {
+	b, err := protojson.Marshal(ex)
+	if err != nil {
+		r.Error = ex.GetDescription()
+		return
+	}
+	r.Exception = b
+}
+
+// exceptionFromError returns the exception carried in the details of a
+// status error returned by the portal, if any.  Business exceptions are
+// carried inside the response message.
+func exceptionFromError(err error) *common.Exception {
+	stat, ok := status.FromError(err)
+	if !ok {
+		return nil
+	}
+	for _, d := range stat.Details() {
+		switch d := d.(type) {
+		case *common.Exception:
+			return d
+		case interface{ GetException() *common.Exception }:
+			if ex := d.GetException(); ex != nil {
+				return ex
+			}
+		}
+	}
+	return nil
+}
+
+// mapImportFields maps the named fields of a record to a claim and, if
+// any claimant fields are set, a claimant.  Field names are proto or JSON
+// field names, and values use the protojson encoding of the field.
+func mapImportFields(fields map[string]json.RawMessage) (*pb.CreateClaimRequest, *pb.Claimant, error) {
+	claimFields := make(map[string]json.RawMessage)
+	claimantFields := make(map[string]json.RawMessage)
+	claimDesc := (&pb.CreateClaimRequest{}).ProtoReflect().Descriptor().Fields()
+	claimantDesc := (&pb.Claimant{}).ProtoReflect().Descriptor().Fields()
+	hasField := func(fds protoreflect.FieldDescriptors, name string) bool {
+		return fds.ByName(protoreflect.Name(name)) != nil || fds.ByJSONName(name) != nil
+	}
+	for name, v := range fields {
+		switch {
+		case hasField(claimDesc, name):
+			claimFields[name] = v
+		case hasField(claimantDesc, name):
+			claimantFields[name] = v
+		default:
+			return nil, nil, fmt.Errorf("unknown field %q", name)
+		}
+	}
+	claim := &pb.CreateClaimRequest{}
+	if err := unmarshalFields(claimFields, claim); err != nil {
+		return nil, nil, err
+	}
+	if len(claimantFields) == 0 {
+		return claim, nil, nil
+	}
+	claimant := &pb.Claimant{}
+	if err := unmarshalFields(claimantFields, claimant); err != nil {
+		return nil, nil, err
+	}
+	return claim, claimant, nil
+}
+
+func unmarshalFields(fields map[string]json.RawMessage, m proto.Message) error {
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	return protojson.Unmarshal(b, m)
+}
+
+// newImportRecord maps the fields read from line to a record.
+func newImportRecord(line int, fields map[string]json.RawMessage) importRecord {
+	claim, claimant, err := mapImportFields(fields)
+	return importRecord{line: line, claim: claim, claimant: claimant, err: err}
+}
+
+// readCSVClaims reads claims from CSV with a header row naming the field in
+// each column.  Empty values are ignored.
+func readCSVClaims(r io.Reader, emit func(importRecord) error) error {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return fmt.Errorf("csv header: %w", err)
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+	for {
+		row, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("csv: %w", err)
+		}
+		line, _ := cr.FieldPos(0)
+		fields := make(map[string]json.RawMessage, len(row))
+		for i, v := range row {
+			if v == "" {
+				continue
+			}
+			b, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			fields[header[i]] = b
+		}
+		if err := emit(newImportRecord(line, fields)); err != nil {
+			return err
+		}
+	}
+}
+
+// readNDJSONClaims reads claims from newline delimited JSON objects.  Blank
+// lines are ignored.
+func readNDJSONClaims(r io.Reader, emit func(importRecord) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		var fields map[string]json.RawMessage
+		rec := importRecord{line: line}
+		if err := json.Unmarshal([]byte(text), &fields); err != nil {
+			rec.err = fmt.Errorf("invalid JSON: %w", err)
+		} else {
+			rec = newImportRecord(line, fields)
+		}
+		if err := emit(rec); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// importClaim creates the claim for rec and adds its claimant.
+func importClaim(ctx context.Context, client srv.SandboxServiceClient, rec importRecord) importResult {
+	res := importResult{Line: rec.line}
+	if rec.err != nil {
+		res.Error = rec.err.Error()
+		return res
+	}
+	created, err := client.CreateClaim(ctx, rec.claim)
+	if err != nil {
+		res.setError(err)
+		return res
+	}
+	if ex := created.GetException(); ex != nil {
+		res.setException(ex)
+		return res
+	}
+	res.ClaimID = created.GetClaim().GetClaimId()
+	if rec.claimant == nil {
+		return res
+	}
+	added, err := client.AddClaimant(ctx, &pb.AddClaimantRequest{
+		ClaimId:  res.ClaimID,
+		Claimant: rec.claimant,
+	})
+	if err != nil {
+		res.setError(err)
+		return res
+	}
+	if ex := added.GetException(); ex != nil {
+		res.setException(ex)
+	}
+	return res
+}
+
+// importClaims imports the records produced by read, at most concurrency at
+// a time, and writes a result for each record to report in the order the
+// records were read.  An error is returned if any record fails to import.
+func importClaims(ctx context.Context, client srv.SandboxServiceClient, read func(emit func(importRecord) error) error, concurrency int, report io.Writer) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	type job struct {
+		seq int
+		rec importRecord
+	}
+	type result struct {
+		seq int
+		res importResult
+	}
+	jobs := make(chan job)
+	results := make(chan result)
+	var readErr error
+	go func() {
+		defer close(jobs)
+		seq := 0
+		readErr = read(func(rec importRecord) error {
+			select {
+			case jobs <- job{seq: seq, rec: rec}:
+				seq++
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+	var wg sync.WaitGroup
+	for range concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				results <- result{seq: j.seq, res: importClaim(ctx, client, j.rec)}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	enc := json.NewEncoder(report)
+	pending := make(map[int]importResult)
+	var next, total, failed int
+	var writeErr error
+	for r := range results {
+		pending[r.seq] = r.res
+		for res, ok := pending[next]; ok; res, ok = pending[next] {
+			delete(pending, next)
+			next++
+			total++
+			if res.failed() {
+				failed++
+			}
+			if writeErr == nil {
+				if writeErr = enc.Encode(res); writeErr != nil {
+					cancel()
+				}
+			}
+		}
+	}
+	switch {
+	case writeErr != nil:
+		return fmt.Errorf("report: %w", writeErr)
+	case readErr != nil:
+		return readErr
+	case failed > 0:
+		return fmt.Errorf("%d of %d claims failed to import", failed, total)
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	common "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/common/v1"
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	srv "github.com/luthersystems/sandbox/api/srvpb/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeClaimsClient creates claims in memory, rejecting claimants without a
+// forename with a business exception.
+type fakeClaimsClient struct {
+	srv.SandboxServiceClient
+	created atomic.Int64
+}
+
+func (c *fakeClaimsClient) CreateClaim(_ context.Context, req *pb.CreateClaimRequest, _ ...grpc.CallOption) (*pb.CreateClaimResponse, error) {
+	id := fmt.Sprintf("claim-%d", c.created.Add(1))
+	return &pb.CreateClaimResponse{Result: &pb.CreateClaimResponse_Claim{Claim: &pb.Claim{
+		ClaimId:      id,
+		DamageAmount: req.GetDamageAmount(),
+	}}}, nil
+}
+
+func (c *fakeClaimsClient) AddClaimant(_ context.Context, req *pb.AddClaimantRequest, _ ...grpc.CallOption) (*pb.AddClaimantResponse, error) {
+	if req.GetClaimant().GetForename() == "" {
+		resp := &pb.AddClaimantResponse{Result: &pb.AddClaimantResponse_Exception{Exception: &common.Exception{
+			Type:        common.Exception_BUSINESS,
+			Description: "missing claimant forename",
+		}}}
+		stat, err := status.New(codes.InvalidArgument, "missing claimant forename").WithDetails(resp)
+		if err != nil {
+			return nil, err
+		}
+		return nil, stat.Err()
+	}
+	return &pb.AddClaimantResponse{Result: &pb.AddClaimantResponse_Claim{Claim: &pb.Claim{ClaimId: req.GetClaimId()}}}, nil
+}
+
+func collectRecords(t *testing.T, read func(func(importRecord) error) error) []importRecord {
+	t.Helper()
+	var recs []importRecord
+	require.NoError(t, read(func(rec importRecord) error {
+		recs = append(recs, rec)
+		return nil
+	}))
+	return recs
+}
+
+func TestReadCSVClaims(t *testing.T) {
+	in := "date_of_accident,damage_amount,claim_reason,forename,surname,nationality\n" +
+		"2024-03-01,125000,Rear-ended,Raymond,Smith,NATIONALITY_GB\n" +
+		"2024-04-02,500,Hail,,,\n" +
+		"2024-05-03,lots,Flood,,,\n"
+	recs := collectRecords(t, func(emit func(importRecord) error) error {
+		return readCSVClaims(strings.NewReader(in), emit)
+	})
+	require.Len(t, recs, 3)
+
+	require.NoError(t, recs[0].err)
+	assert.Equal(t, 2, recs[0].line)
+	assert.Equal(t, "2024-03-01", recs[0].claim.GetDateOfAccident())
+	assert.Equal(t, int64(125000), recs[0].claim.GetDamageAmount())
+	assert.Equal(t, "Raymond", recs[0].claimant.GetForename())
+	assert.Equal(t, pb.Nationality_NATIONALITY_GB, recs[0].claimant.GetNationality())
+
+	require.NoError(t, recs[1].err)
+	assert.Nil(t, recs[1].claimant, "no claimant fields")
+
+	assert.Error(t, recs[2].err, "invalid damage_amount")
+}
+
+func TestReadNDJSONClaims(t *testing.T) {
+	in := `{"date_of_accident": "2024-03-01", "damageAmount": "125000", "forename": "Raymond"}
+
+{"claim_reason": "Hail", "colour": "red"}
+not json
+`
+	recs := collectRecords(t, func(emit func(importRecord) error) error {
+		return readNDJSONClaims(strings.NewReader(in), emit)
+	})
+	require.Len(t, recs, 3)
+	require.NoError(t, recs[0].err)
+	assert.Equal(t, int64(125000), recs[0].claim.GetDamageAmount())
+	assert.Equal(t, "Raymond", recs[0].claimant.GetForename())
+	assert.Equal(t, 3, recs[1].line)
+	assert.ErrorContains(t, recs[1].err, "colour")
+	assert.Equal(t, 4, recs[2].line)
+	assert.Error(t, recs[2].err)
+}
+
+func TestImportClaims(t *testing.T) {
+	in := "claim_reason,forename,surname\n" +
+		"Rear-ended,Raymond,Smith\n" +
+		"Hail,,\n" +
+		"Flood,,Jones\n" +
+		"Fire,Ann,Lee\n"
+	client := &fakeClaimsClient{}
+	var report bytes.Buffer
+	err := importClaims(context.Background(), client, func(emit func(importRecord) error) error {
+		return readCSVClaims(strings.NewReader(in), emit)
+	}, 3, &report)
+	assert.EqualError(t, err, "1 of 4 claims failed to import")
+	assert.Equal(t, int64(4), client.created.Load())
+
+	var results []importResult
+	dec := json.NewDecoder(&report)
+	for dec.More() {
+		var res importResult
+		require.NoError(t, dec.Decode(&res))
+		results = append(results, res)
+	}
+	require.Len(t, results, 4)
+	for i, res := range results {
+		assert.Equal(t, i+2, res.Line, "results are in file order")
+		assert.NotEmpty(t, res.ClaimID)
+	}
+	assert.False(t, results[0].failed())
+	assert.False(t, results[1].failed())
+	assert.True(t, results[2].failed())
+	assert.Contains(t, string(results[2].Exception), "missing claimant forename")
+	assert.False(t, results[3].failed())
+}
+
+func TestImportFormat(t *testing.T) {
+	for file, want := range map[string]string{
+		"claims.csv":    "csv",
+		"claims.CSV":    "csv",
+		"claims.ndjson": "ndjson",
+		"claims.jsonl":  "ndjson",
+	} {
+		got, err := importFormat(file, "auto")
+		require.NoError(t, err)
+		assert.Equal(t, want, got, file)
+	}
+	_, err := importFormat("-", "auto")
+	assert.Error(t, err)
+	got, err := importFormat("-", "csv")
+	require.NoError(t, err)
+	assert.Equal(t, "csv", got)
+}
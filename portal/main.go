@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"syscall"
 
 	"github.com/alecthomas/kong"
 	"github.com/sirupsen/logrus"
@@ -16,18 +17,28 @@ type baseCmd struct {
 }
 
 type cli struct {
-	Version versionCmd `cmd:"version" help:"Get the version"`
-	Start   startCmd   `cmd:"start" help:"Start the portal"`
+	Version  versionCmd  `cmd:"version" help:"Get the version"`
+	Start    startCmd    `cmd:"start" help:"Start the portal"`
+	Import   importCmd   `cmd:"import" help:"Import claims from a CSV or NDJSON file"`
+	Export   exportCmd   `cmd:"export" help:"Export claims as NDJSON, CSV or Parquet"`
+	Snapshot snapshotCmd `cmd:"snapshot" help:"Save and load snapshots of the in-memory phylum"`
+	Claim    claimCmd    `cmd:"claim" help:"Create, get and list claims on a running portal"`
+	Config   configCmd   `cmd:"config" help:"Inspect the configuration of the portal"`
 }
 
+// setupInterruptHandler cancels the context of the command on the first
+// interrupt or SIGTERM, letting it stop gracefully, and exits immediately on
+// the second.
 func setupInterruptHandler(cancel context.CancelFunc) {
-	c := make(chan os.Signal, 1)
-	signal.Notify(c, os.Interrupt)
+	c := make(chan os.Signal, 2)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 	go func() {
-		for range c {
-			fmt.Println("\nReceived an interrupt, stopping tasks...")
-			cancel()
-		}
+		sig := <-c
+		fmt.Printf("\nReceived %s, stopping tasks (repeat to exit immediately)...\n", sig)
+		cancel()
+		sig = <-c
+		fmt.Printf("\nReceived %s again, exiting\n", sig)
+		os.Exit(1)
 	}()
 }
 
@@ -47,6 +58,23 @@ func main() {
 	cli := &cli{
 		Version: versionCmd{baseCmd: baseCmd{ctx: ctx}},
 		Start:   startCmd{baseCmd: baseCmd{ctx: ctx}},
+		Import:  importCmd{baseCmd: baseCmd{ctx: ctx}},
+		Export:  exportCmd{baseCmd: baseCmd{ctx: ctx}},
+		Snapshot: snapshotCmd{
+			Save:    snapshotSaveCmd{baseCmd: baseCmd{ctx: ctx}},
+			Load:    snapshotLoadCmd{baseCmd: baseCmd{ctx: ctx}},
+			Take:    snapshotTakeCmd{baseCmd: baseCmd{ctx: ctx}},
+			Restore: snapshotRestoreCmd{baseCmd: baseCmd{ctx: ctx}},
+		},
+		Claim: claimCmd{
+			Create:      claimCreateCmd{baseCmd: baseCmd{ctx: ctx}},
+			Get:         claimGetCmd{baseCmd: baseCmd{ctx: ctx}},
+			AddClaimant: claimAddClaimantCmd{baseCmd: baseCmd{ctx: ctx}},
+			List:        claimListCmd{baseCmd: baseCmd{ctx: ctx}},
+		},
+		Config: configCmd{
+			Print: configPrintCmd{baseCmd: baseCmd{ctx: ctx}},
+		},
 	}
 
 	kctx := kong.Parse(cli)
@@ -0,0 +1,111 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	srv "github.com/luthersystems/sandbox/api/srvpb/v1"
+	"github.com/luthersystems/svc/oracle"
+	"github.com/luthersystems/svc/svcerr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AdminKeyHeader is the header, or gRPC metadata key, carrying the key which
+// authorizes calls to AdminService.
+const AdminKeyHeader = "X-Admin-Key"
+
+// snapshotChunkSize is the size of the chunks in which snapshots are
+// streamed.
+const snapshotChunkSize = 64 << 10
+
+// maxSnapshotSize is the size of the largest snapshot RestoreSnapshot
+// accepts, which it holds in memory while restoring.
+const maxSnapshotSize = 256 << 20
+
+// adminService administers the portal's emulated phylum.
+type adminService struct {
+	srv.UnimplementedAdminServiceServer
+	p *portal
+	// maxSnapshotSize is the size of the largest snapshot accepted.
+	maxSnapshotSize int
+}
+
+// TakeSnapshot streams the state of the emulated phylum.
+func (a *adminService) TakeSnapshot(_ *pb.TakeSnapshotRequest, stream srv.AdminService_TakeSnapshotServer) error {
+	var buf bytes.Buffer
+	if err := a.p.emu.snapshot(&buf); err != nil {
+		return status.Errorf(codes.Internal, "snapshot: %v", err)
+	}
+	for b := buf.Bytes(); len(b) > 0; {
+		n := min(len(b), snapshotChunkSize)
+		if err := stream.Send(&pb.TakeSnapshotResponse{Data: b[:n]}); err != nil {
+			return err
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// RestoreSnapshot replaces the state of the emulated phylum with the
+// streamed snapshot.  The state is unchanged if the snapshot is invalid, or
+// larger than the service accepts.
+func (a *adminService) RestoreSnapshot(stream srv.AdminService_RestoreSnapshotServer) error {
+	ctx := stream.Context()
+	var buf bytes.Buffer
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if buf.Len()+len(req.GetData()) > a.maxSnapshotSize {
+			return status.Errorf(codes.ResourceExhausted, "snapshot exceeds %d bytes", a.maxSnapshotSize)
+		}
+		buf.Write(req.GetData())
+	}
+	if buf.Len() == 0 {
+		return exceptionError(ctx, svcerr.BusinessException(ctx, "empty snapshot"))
+	}
+	size := buf.Len()
+	if err := a.p.emu.restore(&buf); err != nil {
+		return exceptionError(ctx, svcerr.BusinessException(ctx, fmt.Sprintf("invalid snapshot: %v", err)))
+	}
+	a.p.orc.Log(ctx).WithField("snapshot_bytes", size).Info("snapshot restored")
+	return stream.SendAndClose(&pb.RestoreSnapshotResponse{})
+}
+
+// adminInterceptor rejects AdminService calls unless the portal runs an
+// emulated phylum and the caller presents the admin key.  All calls are
+// rejected if no admin key is configured.
+func (p *portal) adminInterceptor(key string) grpc.UnaryServerInterceptor {
+	digest := sha256.Sum256([]byte(key))
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if p.emu == nil {
+			return nil, status.Error(codes.FailedPrecondition, "admin service requires emulated mode")
+		}
+		if key == "" {
+			return nil, status.Error(codes.PermissionDenied, "admin service is disabled")
+		}
+		got := oracle.GetIncomingHeader(ctx, AdminKeyHeader)
+		if got == "" {
+			return nil, status.Error(codes.Unauthenticated, "missing admin key")
+		}
+		gotDigest := sha256.Sum256([]byte(got))
+		if subtle.ConstantTimeCompare(gotDigest[:], digest[:]) != 1 {
+			return nil, status.Error(codes.Unauthenticated, "invalid admin key")
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	healthcheck "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/healthcheck/v1"
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	srv "github.com/luthersystems/sandbox/api/srvpb/v1"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestAdminInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: srv.AdminService_RestoreSnapshot_FullMethodName}
+	handler := func(context.Context, any) (any, error) { return "ok", nil }
+	withKey := func(key string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(AdminKeyHeader, key))
+	}
+	emulated := &portal{emu: &emulator{}}
+
+	for name, tc := range map[string]struct {
+		p    *portal
+		key  string
+		ctx  context.Context
+		code codes.Code
+	}{
+		"real network": {p: &portal{}, key: "secret", ctx: withKey("secret"), code: codes.FailedPrecondition},
+		"disabled":     {p: emulated, ctx: withKey("secret"), code: codes.PermissionDenied},
+		"missing key":  {p: emulated, key: "secret", ctx: context.Background(), code: codes.Unauthenticated},
+		"wrong key":    {p: emulated, key: "secret", ctx: withKey("guess"), code: codes.Unauthenticated},
+		"valid key":    {p: emulated, key: "secret", ctx: withKey("secret"), code: codes.OK},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp, err := tc.p.adminInterceptor(tc.key)(tc.ctx, nil, info, handler)
+			assert.Equal(t, tc.code, status.Code(err))
+			if tc.code == codes.OK {
+				assert.Equal(t, "ok", resp)
+			}
+		})
+	}
+}
+
+func TestAdminDraining(t *testing.T) {
+	p := &portal{emu: &emulator{}, adminKey: "secret", drain: newDrainer(), requestIDHeader: testRequestIDHeader}
+	server := grpc.NewServer()
+	p.RegisterServiceServer(server)
+	lis := bufconn.Listen(1 << 20)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	p.drain.drain(time.Second)
+
+	refused := rpcRequests.WithLabelValues("RestoreSnapshot", codes.Unavailable.String())
+	before := testutil.ToFloat64(refused)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), AdminKeyHeader, "secret")
+	stream, err := srv.NewAdminServiceClient(conn).RestoreSnapshot(ctx)
+	require.NoError(t, err)
+	_, err = stream.CloseAndRecv()
+	assert.Equal(t, codes.Unavailable, status.Code(err), "admin calls are refused while draining")
+	// The server has no stream interceptors, like the oracle's, yet the
+	// stream is given a request ID.
+	header, err := stream.Header()
+	require.NoError(t, err)
+	assert.Len(t, header.Get(testRequestIDHeader), 1)
+	assert.Equal(t, before+1, testutil.ToFloat64(refused), "admin calls are counted")
+}
+
+func TestRestoreSnapshotTooLarge(t *testing.T) {
+	admin := &adminService{p: &portal{}, maxSnapshotSize: 10}
+	err := admin.RestoreSnapshot(&restoreSnapshotStream{data: make([]byte, 11), size: 4})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+// takeSnapshotStream collects the chunks of a snapshot.
+type takeSnapshotStream struct {
+	grpc.ServerStream
+	buf    bytes.Buffer
+	chunks int
+}
+
+func (s *takeSnapshotStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *takeSnapshotStream) Send(resp *pb.TakeSnapshotResponse) error {
+	s.chunks++
+	s.buf.Write(resp.GetData())
+	return nil
+}
+
+// restoreSnapshotStream streams a snapshot in chunks of at most size bytes.
+type restoreSnapshotStream struct {
+	grpc.ServerStream
+	data []byte
+	size int
+	resp *pb.RestoreSnapshotResponse
+}
+
+func (s *restoreSnapshotStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *restoreSnapshotStream) Recv() (*pb.RestoreSnapshotRequest, error) {
+	if len(s.data) == 0 {
+		return nil, io.EOF
+	}
+	n := min(len(s.data), s.size)
+	req := &pb.RestoreSnapshotRequest{Data: s.data[:n]}
+	s.data = s.data[n:]
+	return req, nil
+}
+
+func (s *restoreSnapshotStream) SendAndClose(resp *pb.RestoreSnapshotResponse) error {
+	s.resp = resp
+	return nil
+}
+
+func TestAdminSnapshot(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStandalone(t, nil)
+	admin := &adminService{p: s.p, maxSnapshotSize: maxSnapshotSize}
+	client := s.Client()
+
+	// Take a snapshot of a sandbox with one claim.
+	created, err := client.CreateClaim(ctx, &pb.CreateClaimRequest{})
+	require.NoError(t, err)
+	id := created.GetClaim().GetClaimId()
+	taken := &takeSnapshotStream{}
+	require.NoError(t, admin.TakeSnapshot(&pb.TakeSnapshotRequest{}, taken))
+	require.NotZero(t, taken.buf.Len())
+	assert.Equal(t, (taken.buf.Len()+snapshotChunkSize-1)/snapshotChunkSize, taken.chunks)
+
+	// Claims created after the snapshot are gone once it is restored.
+	created, err = client.CreateClaim(ctx, &pb.CreateClaimRequest{})
+	require.NoError(t, err)
+	later := created.GetClaim().GetClaimId()
+	restore := &restoreSnapshotStream{data: taken.buf.Bytes(), size: 1000}
+	require.NoError(t, admin.RestoreSnapshot(restore))
+	require.NotNil(t, restore.resp)
+
+	got, err := client.GetClaim(ctx, &pb.GetClaimRequest{ClaimId: id})
+	require.NoError(t, err)
+	assert.Equal(t, id, got.GetClaim().GetClaimId())
+	_, err = client.GetClaim(ctx, &pb.GetClaimRequest{ClaimId: later})
+	assert.Error(t, err)
+	// The oracle's health checks reach the restored phylum.
+	_, err = client.GetHealthCheck(ctx, &healthcheck.GetHealthCheckRequest{})
+	require.NoError(t, err)
+
+	// Invalid snapshots leave the state unchanged.
+	err = admin.RestoreSnapshot(&restoreSnapshotStream{data: []byte("not a snapshot"), size: 1000})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = admin.RestoreSnapshot(&restoreSnapshotStream{size: 1000})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	got, err = client.GetClaim(ctx, &pb.GetClaimRequest{ClaimId: id})
+	require.NoError(t, err)
+	assert.Equal(t, id, got.GetClaim().GetClaimId())
+}
@@ -0,0 +1,202 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/luthersystems/svc/oracle"
+	"github.com/sirupsen/logrus"
+)
+
+// APIKeyHeader is the header carrying the caller's API key, as declared by
+// the X-API-KEY security definition in api/srvpb.
+const APIKeyHeader = "X-API-KEY"
+
+// apiKeyReloadInterval is how often the API key file is checked for changes.
+const apiKeyReloadInterval = 10 * time.Second
+
+type apiKey struct {
+	name   string
+	digest [sha256.Size]byte
+}
+
+// apiKeyring holds the API keys accepted by the portal.  Keys are named so
+// that a replacement key can be issued and the old key revoked later, without
+// interrupting clients.  Keys read from a file are reloaded when the file
+// changes.
+type apiKeyring struct {
+	file   string
+	static []apiKey
+
+	mu      sync.RWMutex
+	keys    []apiKey
+	modTime time.Time
+}
+
+// newAPIKeyring returns a keyring holding the named keys and those in file,
+// if file is not empty.
+func newAPIKeyring(file string, keys map[string]string) (*apiKeyring, error) {
+	k := &apiKeyring{file: file}
+	for name, key := range keys {
+		if err := validAPIKey(name, key); err != nil {
+			return nil, err
+		}
+		k.static = append(k.static, newAPIKey(name, key))
+	}
+	k.keys = k.static
+	if err := k.reload(); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+func newAPIKey(name, key string) apiKey {
+	return apiKey{name: name, digest: sha256.Sum256([]byte(key))}
+}
+
+func validAPIKey(name, key string) error {
+	if name == "" {
+		return fmt.Errorf("api key: missing name")
+	}
+	if key == "" {
+		return fmt.Errorf("api key %q: missing key", name)
+	}
+	return nil
+}
+
+// parseAPIKeys reads API keys, one "name=key" pair per line.  Blank lines and
+// lines starting with "#" are ignored.
+func parseAPIKeys(r io.Reader) (map[string]string, error) {
+	keys := make(map[string]string)
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, key, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected name=key", n)
+		}
+		name, key = strings.TrimSpace(name), strings.TrimSpace(key)
+		if err := validAPIKey(name, key); err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+		if _, ok := keys[name]; ok {
+			return nil, fmt.Errorf("line %d: duplicate api key %q", n, name)
+		}
+		keys[name] = key
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// reload re-reads the key file if it has been modified since it was last
+// read.
+func (k *apiKeyring) reload() error {
+	if k.file == "" {
+		return nil
+	}
+	f, err := os.Open(k.file)
+	if err != nil {
+		return fmt.Errorf("api key file: %w", err)
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("api key file: %w", err)
+	}
+	k.mu.RLock()
+	unchanged := info.ModTime().Equal(k.modTime)
+	k.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+	fileKeys, err := parseAPIKeys(f)
+	if err != nil {
+		return fmt.Errorf("api key file %s: %w", k.file, err)
+	}
+	keys := slices.Clone(k.static)
+	for name, key := range fileKeys {
+		keys = append(keys, newAPIKey(name, key))
+	}
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.keys = keys
+	k.modTime = info.ModTime()
+	return nil
+}
+
+// watch reloads the key file periodically until ctx is done.  A key file
+// that fails to load leaves the previous keys in place.
+func (k *apiKeyring) watch(ctx context.Context, log func(context.Context) *logrus.Entry) {
+	if k.file == "" {
+		return
+	}
+	ticker := time.NewTicker(apiKeyReloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := k.reload(); err != nil {
+				log(ctx).WithError(err).Warn("failed to reload api keys")
+			}
+		}
+	}
+}
+
+// empty returns true if the keyring accepts no keys.
+func (k *apiKeyring) empty() bool {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return len(k.keys) == 0
+}
+
+// lookup returns the name of key, if it is valid.
+func (k *apiKeyring) lookup(key string) (string, bool) {
+	if key == "" {
+		return "", false
+	}
+	digest := sha256.Sum256([]byte(key))
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	name, ok := "", false
+	// Compare against every key so that timing does not reveal which key
+	// matched.
+	for _, candidate := range k.keys {
+		if subtle.ConstantTimeCompare(digest[:], candidate.digest[:]) == 1 {
+			name, ok = candidate.name, true
+		}
+	}
+	return name, ok
+}
+
+// authenticate identifies callers presenting an API key in the
+// APIKeyHeader.
+func (k *apiKeyring) authenticate(ctx context.Context) (*principal, error) {
+	key := oracle.GetIncomingHeader(ctx, APIKeyHeader)
+	if key == "" {
+		return nil, nil
+	}
+	name, ok := k.lookup(key)
+	if !ok {
+		return nil, errors.New("invalid api key")
+	}
+	return &principal{kind: apiKeyPrincipal, subject: name}, nil
+}
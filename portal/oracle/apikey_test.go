@@ -0,0 +1,204 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	common "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/common/v1"
+	healthcheck "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/healthcheck/v1"
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	srv "github.com/luthersystems/sandbox/api/srvpb/v1"
+	"github.com/luthersystems/svc/svcerr"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func writeAPIKeyFile(t *testing.T, path, content string, modTime time.Time) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestParseAPIKeys(t *testing.T) {
+	keys, err := parseAPIKeys(strings.NewReader(`
+# frontend keys
+frontend = abc123
+batch=def=456
+`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"frontend": "abc123", "batch": "def=456"}, keys)
+
+	for name, content := range map[string]string{
+		"missing separator": "frontend abc123",
+		"missing name":      "=abc123",
+		"missing key":       "frontend=",
+		"duplicate name":    "frontend=abc\nfrontend=def",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseAPIKeys(strings.NewReader(content))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestAPIKeyringRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api-keys")
+	start := time.Now().Add(-time.Hour)
+	writeAPIKeyFile(t, path, "old=key-1\n", start)
+	keys, err := newAPIKeyring(path, map[string]string{"ops": "key-ops"})
+	require.NoError(t, err)
+
+	name, ok := keys.lookup("key-1")
+	assert.True(t, ok)
+	assert.Equal(t, "old", name)
+	name, ok = keys.lookup("key-ops")
+	assert.True(t, ok)
+	assert.Equal(t, "ops", name)
+
+	// Issue a new key alongside the old one.
+	writeAPIKeyFile(t, path, "old=key-1\nnew=key-2\n", start.Add(time.Minute))
+	require.NoError(t, keys.reload())
+	_, ok = keys.lookup("key-1")
+	assert.True(t, ok)
+	name, ok = keys.lookup("key-2")
+	assert.True(t, ok)
+	assert.Equal(t, "new", name)
+
+	// Revoke the old key.
+	writeAPIKeyFile(t, path, "new=key-2\n", start.Add(2*time.Minute))
+	require.NoError(t, keys.reload())
+	_, ok = keys.lookup("key-1")
+	assert.False(t, ok)
+	_, ok = keys.lookup("key-2")
+	assert.True(t, ok)
+
+	// A broken file keeps the current keys.
+	writeAPIKeyFile(t, path, "broken", start.Add(3*time.Minute))
+	require.Error(t, keys.reload())
+	_, ok = keys.lookup("key-2")
+	assert.True(t, ok)
+
+	_, ok = keys.lookup("")
+	assert.False(t, ok)
+}
+
+// stubService answers GetHealthCheck, GetClaim, CreateClaim and
+// ExportClaims without a phylum.
+type stubService struct {
+	srv.UnimplementedSandboxServiceServer
+}
+
+// stubClaims counts the claims created by stubService.
+var stubClaims atomic.Int64
+
+// stubETagMetadata is the header metadata key of the ETag sent by
+// stubService.
+const stubETagMetadata = "etag"
+
+// CreateClaim returns a claim with a new ID on each call, or fails if the
+// claim reason is "fail".
+// CreateClaim fails, or returns an exception, for the claim reasons "fail"
+// and "exception".  Claims it creates are sent with their ID as the ETag.
+func (stubService) CreateClaim(ctx context.Context, req *pb.CreateClaimRequest) (*pb.CreateClaimResponse, error) {
+	switch req.GetClaimReason() {
+	case "fail":
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	case "exception":
+		stubClaims.Add(1)
+		return &pb.CreateClaimResponse{Result: &pb.CreateClaimResponse_Exception{Exception: &common.Exception{
+			Type:        common.Exception_SERVICE_NOT_AVAILABLE,
+			Description: "try again",
+		}}}, nil
+	}
+	id := fmt.Sprintf("claim-%d", stubClaims.Add(1))
+	if err := grpc.SetHeader(ctx, metadata.Pairs(stubETagMetadata, id)); err != nil {
+		return nil, err
+	}
+	return &pb.CreateClaimResponse{Result: &pb.CreateClaimResponse_Claim{Claim: &pb.Claim{ClaimId: id}}}, nil
+}
+
+func (stubService) GetHealthCheck(context.Context, *healthcheck.GetHealthCheckRequest) (*healthcheck.GetHealthCheckResponse, error) {
+	return &healthcheck.GetHealthCheckResponse{}, nil
+}
+
+func (stubService) GetClaim(context.Context, *pb.GetClaimRequest) (*pb.GetClaimResponse, error) {
+	return &pb.GetClaimResponse{Result: &pb.GetClaimResponse_Claim{Claim: &pb.Claim{ClaimId: testClaimID}}}, nil
+}
+
+// ExportClaims streams a single claim whose ID is the caller's subject.
+func (stubService) ExportClaims(_ *pb.ExportClaimsRequest, stream srv.SandboxService_ExportClaimsServer) error {
+	var subject string
+	if p := principalFromContext(stream.Context()); p != nil {
+		subject = p.subject
+	}
+	return stream.Send(&pb.ExportClaimsResponse{Claim: &pb.Claim{ClaimId: subject}})
+}
+
+// newStubClient serves stubService with the oracle's error handling and the
+// given interceptors, and returns a client connected to it.
+func newStubClient(t *testing.T, interceptors ...grpc.UnaryServerInterceptor) srv.SandboxServiceClient {
+	t.Helper()
+	log := func(context.Context) *logrus.Entry { return logrus.NewEntry(logrus.StandardLogger()) }
+	server := grpc.NewServer(grpc.UnaryInterceptor(svcerr.AppErrorUnaryInterceptor(log)))
+	server.RegisterService(withInterceptors(&srv.SandboxService_ServiceDesc, interceptors...), stubService{})
+	lis := bufconn.Listen(1 << 20)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return srv.NewSandboxServiceClient(conn)
+}
+
+func TestAPIKeyInterceptor(t *testing.T) {
+	keys, err := newAPIKeyring("", map[string]string{"frontend": "secret"})
+	require.NoError(t, err)
+	client := newStubClient(t, authInterceptor([]authenticator{keys}, publicMethods...))
+	ctx := context.Background()
+	withKey := func(key string) context.Context {
+		return metadata.AppendToOutgoingContext(ctx, APIKeyHeader, key)
+	}
+
+	resp, err := client.GetClaim(withKey("secret"), &pb.GetClaimRequest{ClaimId: testClaimID})
+	require.NoError(t, err)
+	assert.Equal(t, testClaimID, resp.GetClaim().GetClaimId())
+
+	_, err = client.GetHealthCheck(ctx, &healthcheck.GetHealthCheckRequest{})
+	require.NoError(t, err)
+
+	for name, ctx := range map[string]context.Context{
+		"missing key": ctx,
+		"wrong key":   withKey("guess"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.GetClaim(ctx, &pb.GetClaimRequest{ClaimId: testClaimID})
+			stat, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, codes.Unauthenticated, stat.Code())
+			require.Len(t, stat.Details(), 1)
+			ex, ok := stat.Details()[0].(*common.Exception)
+			require.True(t, ok)
+			assert.Equal(t, common.Exception_SECURITY_VIOLATION, ex.GetType())
+		})
+	}
+}
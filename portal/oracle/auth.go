@@ -0,0 +1,88 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"slices"
+
+	srv "github.com/luthersystems/sandbox/api/srvpb/v1"
+	"github.com/luthersystems/svc/grpclogging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// healthMethods report the health of the portal to load balancers and
+// orchestrators.
+var healthMethods = []string{
+	srv.SandboxService_GetHealthCheck_FullMethodName,
+	srv.SandboxService_GetLiveness_FullMethodName,
+	srv.SandboxService_GetReadiness_FullMethodName,
+}
+
+// publicMethods may be called without authentication, e.g. by load balancer
+// health checks.
+var publicMethods = healthMethods
+
+// principalKind is the means by which a principal was authenticated.
+type principalKind string
+
+const (
+	apiKeyPrincipal principalKind = "api_key"
+	jwtPrincipal    principalKind = "jwt"
+)
+
+// principal identifies an authenticated caller.
+type principal struct {
+	kind principalKind
+	// subject names the caller: the name of its API key or the subject of
+	// its token.
+	subject string
+	// roles are the roles asserted by the caller's credentials.
+	roles []string
+}
+
+type principalContextKey struct{}
+
+// withPrincipal returns a context identifying the caller as p.
+func withPrincipal(ctx context.Context, p *principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, p)
+}
+
+// principalFromContext returns the authenticated caller, or nil if the call
+// was not authenticated.
+func principalFromContext(ctx context.Context) *principal {
+	p, _ := ctx.Value(principalContextKey{}).(*principal)
+	return p
+}
+
+// authenticator identifies the caller of a request from its metadata.
+type authenticator interface {
+	// authenticate returns the caller identified by the request credentials
+	// it recognizes, or nil if the request carries no such credentials.  An
+	// error is returned if the credentials are invalid.
+	authenticate(ctx context.Context) (*principal, error)
+}
+
+// authInterceptor rejects calls which are not authenticated by one of the
+// authenticators, except for the exempt methods.  The caller is identified
+// to later handlers by principalFromContext.
+func authInterceptor(authenticators []authenticator, exempt ...string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if slices.Contains(exempt, info.FullMethod) {
+			return handler(ctx, req)
+		}
+		for _, a := range authenticators {
+			p, err := a.authenticate(ctx)
+			if err != nil {
+				return nil, status.Error(codes.Unauthenticated, err.Error())
+			}
+			if p != nil {
+				grpclogging.AddLogrusField(ctx, string(p.kind), p.subject)
+				return handler(withPrincipal(ctx, p), req)
+			}
+		}
+		return nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+}
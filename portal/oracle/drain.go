@@ -0,0 +1,96 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/status"
+)
+
+// drainer tracks the calls in progress so that the portal can wait for
+// their transactions to complete before it stops.  Once draining, it
+// refuses new calls and reports the portal as not serving.
+type drainer struct {
+	mu       sync.Mutex
+	draining bool
+	inflight sync.WaitGroup
+	// n is the number of calls in progress.
+	n atomic.Int64
+	// health reports the serving status of the portal's gRPC services.
+	health *health.Server
+}
+
+func newDrainer() *drainer {
+	return &drainer{health: health.NewServer()}
+}
+
+// isDraining reports whether the portal is shutting down.
+func (d *drainer) isDraining() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.draining
+}
+
+// start records the start of a call, unless the portal is draining.  The
+// returned function records its end.
+func (d *drainer) start() (func(), bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.draining {
+		return nil, false
+	}
+	d.inflight.Add(1)
+	d.n.Add(1)
+	return func() {
+		d.n.Add(-1)
+		d.inflight.Done()
+	}, true
+}
+
+// interceptor tracks the calls in progress and refuses calls once the
+// portal is draining, except those of the exempt methods, such as health
+// checks, which must still be answered.
+func (d *drainer) interceptor(exempt ...string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if slices.Contains(exempt, info.FullMethod) {
+			return handler(ctx, req)
+		}
+		done, ok := d.start()
+		if !ok {
+			return nil, status.Error(codes.Unavailable, "portal is shutting down")
+		}
+		defer done()
+		return handler(ctx, req)
+	}
+}
+
+// drain refuses new calls, reports the portal as not serving and waits up to
+// timeout for the calls in progress to complete.  It returns the number of
+// calls still in progress when it gave up.
+func (d *drainer) drain(timeout time.Duration) int64 {
+	d.mu.Lock()
+	d.draining = true
+	d.mu.Unlock()
+	d.health.Shutdown()
+	done := make(chan struct{})
+	go func() {
+		d.inflight.Wait()
+		close(done)
+	}()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-done:
+		return 0
+	case <-timer.C:
+		return d.n.Load()
+	}
+}
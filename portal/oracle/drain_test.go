@@ -0,0 +1,71 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	srv "github.com/luthersystems/sandbox/api/srvpb/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestDrainer(t *testing.T) {
+	ctx := context.Background()
+	d := newDrainer()
+	intercept := d.interceptor(srv.SandboxService_GetHealthCheck_FullMethodName)
+	call := func(method string, handler grpc.UnaryHandler) error {
+		_, err := intercept(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+	ok := func(context.Context, any) (any, error) { return "ok", nil }
+
+	require.NoError(t, call(srv.SandboxService_CreateClaim_FullMethodName, ok))
+
+	// A call in progress holds up the drain until it completes.
+	started, release := make(chan struct{}), make(chan struct{})
+	errCall := make(chan error, 1)
+	go func() {
+		errCall <- call(srv.SandboxService_CreateClaim_FullMethodName, func(context.Context, any) (any, error) {
+			close(started)
+			<-release
+			return "ok", nil
+		})
+	}()
+	<-started
+	drained := make(chan int64, 1)
+	go func() { drained <- d.drain(time.Minute) }()
+	require.Eventually(t, d.isDraining, time.Second, time.Millisecond)
+
+	err := call(srv.SandboxService_GetClaim_FullMethodName, ok)
+	assert.Equal(t, codes.Unavailable, status.Code(err), "new calls are refused")
+	assert.NoError(t, call(srv.SandboxService_GetHealthCheck_FullMethodName, ok), "health checks are answered")
+	resp, err := d.health.Check(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, resp.GetStatus())
+
+	select {
+	case <-drained:
+		t.Fatal("drain completed with a call in progress")
+	case <-time.After(10 * time.Millisecond):
+	}
+	close(release)
+	assert.NoError(t, <-errCall)
+	assert.Zero(t, <-drained)
+}
+
+func TestDrainerTimeout(t *testing.T) {
+	d := newDrainer()
+	done, ok := d.start()
+	require.True(t, ok)
+	defer done()
+	assert.Equal(t, int64(1), d.drain(10*time.Millisecond), "the call still in progress is reported")
+	_, ok = d.start()
+	assert.False(t, ok)
+}
@@ -0,0 +1,146 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	healthcheck "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/healthcheck/v1"
+	"github.com/luthersystems/shiroclient-sdk-go/shiroclient"
+	"github.com/luthersystems/shiroclient-sdk-go/shiroclient/phylum"
+	"github.com/luthersystems/svc/grpclogging"
+	"github.com/luthersystems/svc/oracle"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/proto"
+)
+
+// emulator runs the phylum in memory for a portal in emulated mode.  The
+// portal owns the emulated phylum, rather than the oracle, so that its state
+// can be saved to and restored from snapshots.
+type emulator struct {
+	phylumPath string
+	configPath string
+	log        *logrus.Entry
+	// mu is held for reading by calls to the phylum, and for writing while
+	// its state is captured or replaced.
+	mu     sync.RWMutex
+	client *phylum.Client
+}
+
+// newEmulator starts the phylum at phylumPath in memory, restoring its state
+// from snapshot if it is not nil.  Otherwise the phylum is bootstrapped from
+// the optional configPath.
+func newEmulator(phylumPath, configPath string, log *logrus.Entry, snapshot io.Reader) (*emulator, error) {
+	e := &emulator{phylumPath: phylumPath, configPath: configPath, log: log}
+	client, err := e.start(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	e.client = client
+	return e, nil
+}
+
+func (e *emulator) start(snapshot io.Reader) (*phylum.Client, error) {
+	var client *phylum.Client
+	var err error
+	if snapshot != nil {
+		client, err = phylum.NewMockFrom(e.phylumPath, e.log, snapshot)
+	} else {
+		client, err = phylum.NewMockWithConfig(e.phylumPath, e.log, e.configPath)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("emulated phylum: %w", err)
+	}
+	client.GetLogMetadata = grpclogging.GetLogrusFields
+	return client, nil
+}
+
+// snapshot writes the state of the phylum to w.  Calls to the phylum wait
+// until the snapshot is complete so that it is consistent.
+func (e *emulator) snapshot(w io.Writer) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.client.MockSnapshot(w)
+}
+
+// restore replaces the state of the phylum with the snapshot read from r.
+// The current state is kept if the snapshot cannot be restored.
+func (e *emulator) restore(r io.Reader) error {
+	client, err := e.start(r)
+	if err != nil {
+		return err
+	}
+	e.mu.Lock()
+	old := e.client
+	e.client = client
+	e.mu.Unlock()
+	return old.Close()
+}
+
+// healthCheck returns the health reports of the phylum.  A phylum which
+// fails to respond is reported DOWN under serviceName.
+func (e *emulator) healthCheck(ctx context.Context, serviceName string) []*healthcheck.HealthCheckReport {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	resp, err := e.client.GetHealthCheck(ctx, []string{"phylum"}, shiroclient.WithLogrusFields(grpclogging.GetLogrusFields(ctx)))
+	if err != nil {
+		e.log.WithError(err).Warn("emulated phylum health check failed")
+		return []*healthcheck.HealthCheckReport{report(serviceName, "", statusDown)}
+	}
+	return resp.GetReports()
+}
+
+func (e *emulator) close() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.client.Close()
+}
+
+// callPhylum calls methodName on the portal's emulated phylum, if it has
+// one, and otherwise through the oracle.  Emulated calls carry the request
+// logging fields the oracle adds to its own phylum calls.  The request ID is
+// passed to the phylum as transient data, so that the phylum can log it, and
+// the duration of every call is recorded by route.
+func callPhylum[K proto.Message, R proto.Message](p *portal, ctx context.Context, methodName string, req K, resp R, config ...shiroclient.Config) (R, error) {
+	defer observePhylumCall(methodName, time.Now())
+	id := grpclogging.ReqID(ctx)
+	if id != "" {
+		config = append(config, shiroclient.WithTransientData(requestIDTransientKey, []byte(id)))
+	}
+	if p.emu == nil {
+		return oracle.Call(p.orc, ctx, methodName, req, resp, config...)
+	}
+	ctx, span := p.orc.Tracer().Span(ctx, methodName)
+	defer span.End()
+	configs := []shiroclient.Config{shiroclient.WithLogrusFields(grpclogging.GetLogrusFields(ctx))}
+	if id != "" {
+		span.SetAttributes(attribute.String(requestIDAttribute, id))
+		configs = append(configs, shiroclient.WithID(id))
+	}
+	configs = append(configs, config...)
+	p.emu.mu.RLock()
+	defer p.emu.mu.RUnlock()
+	return phylum.Call(p.emu.client, ctx, methodName, req, resp, configs...)
+}
+
+// healthCheck returns the health reports of the portal and its phylum.  In
+// emulated mode the reports are built as the oracle builds them, but for the
+// portal's phylum, since the oracle's own is idle.
+func (p *portal) healthCheck(ctx context.Context, req *healthcheck.GetHealthCheckRequest) (*healthcheck.GetHealthCheckResponse, error) {
+	if p.emu == nil {
+		return p.orc.GetHealthCheck(ctx, req)
+	}
+	ctx, span := p.orc.Tracer().Span(ctx, "HealthCheck")
+	defer span.End()
+	var reports []*healthcheck.HealthCheckReport
+	if !req.GetHttpOnly() {
+		reports = p.emu.healthCheck(ctx, p.phylumServiceName)
+	}
+	reports = append(reports, report(p.serviceName, p.version, statusUp))
+	return &healthcheck.GetHealthCheckResponse{Reports: reports}, nil
+}
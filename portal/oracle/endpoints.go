@@ -2,13 +2,16 @@ package oracle
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	healthcheck "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/healthcheck/v1"
 	pb "github.com/luthersystems/sandbox/api/pb/v1"
 	"github.com/luthersystems/shiroclient-sdk-go/shiroclient"
 	"github.com/luthersystems/shiroclient-sdk-go/shiroclient/private"
 	"github.com/luthersystems/svc/opttrace"
-	"github.com/luthersystems/svc/oracle"
+	"github.com/luthersystems/svc/svcerr"
+	"google.golang.org/protobuf/proto"
 )
 
 func (p *portal) defaultConfigs(_ context.Context) []shiroclient.Config {
@@ -19,9 +22,58 @@ func (p *portal) defaultConfigs(_ context.Context) []shiroclient.Config {
 	return []shiroclient.Config{cfg}
 }
 
-// GetHealthCheck returns health status.
+// actorTransientKey is the transient data key identifying the authenticated
+// caller to the phylum.
+const actorTransientKey = "actor"
+
+// call validates req and, if it is well formed, forwards it to the phylum
+// route methodName.  Invalid requests fail with an InvalidArgument status
+// carrying a business exception, which the gateway renders as a 400, and
+// never reach the phylum.  The subject of the authenticated caller, if any,
+// is passed to the phylum as transient data.  Revision conflicts reported
+// by the phylum are returned as Aborted errors, and the revision of a
+// returned claim is sent as its ETag.
+func call[K proto.Message, R proto.Message](p *portal, ctx context.Context, methodName string, req K, resp R, config ...shiroclient.Config) (R, error) {
+	var zero R
+	if ex := applyIfMatch(ctx, req); ex != nil {
+		return zero, exceptionError(ctx, ex)
+	}
+	ex, err := validateRequest(ctx, req)
+	if err != nil {
+		return zero, err
+	}
+	if ex != nil {
+		return zero, exceptionError(ctx, ex)
+	}
+	if pr := principalFromContext(ctx); pr != nil {
+		config = append(config, shiroclient.WithTransientData(actorTransientKey, []byte(pr.subject)))
+	}
+	resp, err = callPhylum(p, ctx, methodName, req, resp, config...)
+	if err != nil {
+		return resp, err
+	}
+	if err := conflictError(ctx, responseException(resp)); err != nil {
+		return zero, err
+	}
+	if revision := claimRevision(resp); revision != 0 {
+		p.etag.SetValue(ctx, revisionETag(revision))
+	}
+	return resp, nil
+}
+
+// GetHealthCheck returns health status.  The portal reports itself DOWN
+// while it drains calls before stopping.
 func (p *portal) GetHealthCheck(ctx context.Context, req *healthcheck.GetHealthCheckRequest) (*healthcheck.GetHealthCheckResponse, error) {
-	return p.orc.GetHealthCheck(ctx, req)
+	resp, err := p.healthCheck(ctx, req)
+	if err != nil || p.drain == nil || !p.drain.isDraining() {
+		return resp, err
+	}
+	for _, report := range resp.GetReports() {
+		if report.GetServiceName() == p.serviceName {
+			report.Status = "DOWN"
+		}
+	}
+	return resp, nil
 }
 
 // CreateClaim is an example resource creation endpoint.
@@ -33,16 +85,69 @@ func (p *portal) CreateClaim(ctx context.Context, req *pb.CreateClaimRequest) (*
 	if err != nil {
 		p.orc.Log(ctx).WithError(err).Warn("tracing disabled")
 	}
-	return oracle.Call(p.orc, ctx, "create_claim", req, &pb.CreateClaimResponse{}, p.defaultConfigs(ctx)...)
+	if err := validateCreateClaim(req, time.Now()); err != nil {
+		return nil, exceptionError(ctx, svcerr.BusinessException(ctx, err.Error()))
+	}
+	return call(p, ctx, "create_claim", req, &pb.CreateClaimResponse{}, p.defaultConfigs(ctx)...)
+}
+
+// validateCreateClaim checks the claim details that cannot be expressed as
+// declarative constraints on the request.
+func validateCreateClaim(req *pb.CreateClaimRequest, now time.Time) error {
+	if d := req.GetDateOfAccident(); d != "" {
+		date, err := time.Parse(time.DateOnly, d)
+		if err != nil {
+			return fmt.Errorf("invalid date_of_accident %q: expected YYYY-MM-DD", d)
+		}
+		if date.After(now) {
+			return fmt.Errorf("invalid date_of_accident %q: date is in the future", d)
+		}
+	}
+	return nil
 }
 
 // AddClaimant is an example resource update endpoint.
 func (p *portal) AddClaimant(ctx context.Context, req *pb.AddClaimantRequest) (*pb.AddClaimantResponse, error) {
 	// Normal tracing enabled, WITH elps filtering.
-	return oracle.Call(p.orc, ctx, "add_claimant", req, &pb.AddClaimantResponse{}, p.defaultConfigs(ctx)...)
+	return call(p, ctx, "add_claimant", req, &pb.AddClaimantResponse{}, p.defaultConfigs(ctx)...)
+}
+
+// AdvanceClaim submits the connector response for a claim's current state,
+// moving the claim on to the next state in its workflow.
+func (p *portal) AdvanceClaim(ctx context.Context, req *pb.AdvanceClaimRequest) (*pb.AdvanceClaimResponse, error) {
+	return call(p, ctx, "advance_claim", req, &pb.AdvanceClaimResponse{}, p.defaultConfigs(ctx)...)
+}
+
+// SetClaimStatus records the final outcome of a claim.
+func (p *portal) SetClaimStatus(ctx context.Context, req *pb.SetClaimStatusRequest) (*pb.SetClaimStatusResponse, error) {
+	return call(p, ctx, "set_claim_status", req, &pb.SetClaimStatusResponse{}, p.defaultConfigs(ctx)...)
+}
+
+// DeclineClaim declines a claim, halting its workflow.
+func (p *portal) DeclineClaim(ctx context.Context, req *pb.DeclineClaimRequest) (*pb.DeclineClaimResponse, error) {
+	return call(p, ctx, "decline_claim", req, &pb.DeclineClaimResponse{}, p.defaultConfigs(ctx)...)
 }
 
 // GetClaim is an example query endpoint.
 func (p *portal) GetClaim(ctx context.Context, req *pb.GetClaimRequest) (*pb.GetClaimResponse, error) {
-	return oracle.Call(p.orc, ctx, "get_claim", req, &pb.GetClaimResponse{})
+	return call(p, ctx, "get_claim", req, &pb.GetClaimResponse{})
+}
+
+// GetClaimHistory returns the state transitions of a claim.
+func (p *portal) GetClaimHistory(ctx context.Context, req *pb.GetClaimHistoryRequest) (*pb.GetClaimHistoryResponse, error) {
+	return call(p, ctx, "get_claim_history", req, &pb.GetClaimHistoryResponse{})
+}
+
+// ListClaimEvents returns the outstanding and completed connector events of a
+// claim.
+func (p *portal) ListClaimEvents(ctx context.Context, req *pb.ListClaimEventsRequest) (*pb.ListClaimEventsResponse, error) {
+	return call(p, ctx, "list_claim_events", req, &pb.ListClaimEventsResponse{})
+}
+
+// ListClaims returns a page of claims in creation order, optionally filtered
+// by state, final status and date of accident.  Pages hold up to page_size
+// claims, 50 by default and at most 500; a non-empty next_page_token is
+// passed as page_token to fetch the next page.
+func (p *portal) ListClaims(ctx context.Context, req *pb.ListClaimsRequest) (*pb.ListClaimsResponse, error) {
+	return call(p, ctx, "list_claims", req, &pb.ListClaimsResponse{})
 }
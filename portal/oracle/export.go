@@ -0,0 +1,49 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	srv "github.com/luthersystems/sandbox/api/srvpb/v1"
+)
+
+// exportPageSize is the number of claims read from the phylum at a time
+// during an export.
+const exportPageSize = 500
+
+// ExportClaims streams every claim matching the request filters.  Claims are
+// read a page at a time, so a client sees the first claims before the whole
+// ledger has been scanned.
+func (p *portal) ExportClaims(req *pb.ExportClaimsRequest, stream srv.SandboxService_ExportClaimsServer) error {
+	ctx := stream.Context()
+	ex, err := validateRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+	if ex != nil {
+		return exceptionError(ctx, ex)
+	}
+	page := &pb.ListClaimsRequest{
+		PageSize: exportPageSize,
+		States:   req.GetStates(),
+		Statuses: req.GetStatuses(),
+	}
+	for {
+		resp, err := p.ListClaims(ctx, page)
+		if err != nil {
+			return err
+		}
+		if ex := resp.GetException(); ex != nil {
+			return exceptionError(ctx, ex)
+		}
+		for _, claim := range resp.GetClaims() {
+			if err := stream.Send(&pb.ExportClaimsResponse{Claim: claim}); err != nil {
+				return err
+			}
+		}
+		if resp.GetNextPageToken() == "" {
+			return nil
+		}
+		page.PageToken = resp.GetNextPageToken()
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"time"
+
+	healthcheck "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/healthcheck/v1"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"github.com/luthersystems/svc/midware"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// healthCheckPath is the path of the REST health check.  The oracle serves
+// it without calling the portal, so the portal overrides it.
+const healthCheckPath = "/v1/health_check"
+
+// loopbackAddress returns a free address on the loopback interface, for the
+// oracle's gateway to listen on behind the portal.
+func loopbackAddress() (string, error) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", fmt.Errorf("gateway listen: %w", err)
+	}
+	addr := lis.Addr().String()
+	return addr, lis.Close()
+}
+
+// serveGateway serves the REST API on addr until ctx is done, passing
+// requests to the oracle's gateway at gatewayAddr.
+func (p *portal) serveGateway(ctx context.Context, addr, gatewayAddr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("gateway listen: %w", err)
+	}
+	server := &http.Server{
+		Handler:           p.gatewayHandler(&url.URL{Scheme: "http", Host: gatewayAddr}),
+		ReadHeaderTimeout: 3 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		_ = server.Close()
+	}()
+	p.orc.Log(ctx).WithField("listen_address", addr).Info("gateway listen")
+	if err := server.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("gateway serve: %w", err)
+	}
+	return nil
+}
+
+// gatewayHandler passes requests to the oracle's gateway at target, except
+// health checks, which the portal answers itself.
+func (p *portal) gatewayHandler(target *url.URL) http.Handler {
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	// Streamed responses, such as exports, are passed on as they arrive.
+	proxy.FlushInterval = -1
+	return midware.PathOverrides{healthCheckPath: p.healthCheckHandler()}.Wrap(proxy)
+}
+
+// healthCheckHandler serves GetHealthCheck over REST as the oracle does,
+// with status 503 unless every report is UP.  Unlike the oracle's handler
+// it reports the portal DOWN while it drains.
+func (p *portal) healthCheckHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		req := &healthcheck.GetHealthCheckRequest{}
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, fmt.Sprintf("invalid request: %s", err), http.StatusBadRequest)
+			return
+		}
+		if err := runtime.PopulateQueryParameters(req, r.Form, utilities.NewDoubleArray(nil)); err != nil {
+			http.Error(w, fmt.Sprintf("invalid request: %s", err), http.StatusBadRequest)
+			return
+		}
+		resp, err := p.GetHealthCheck(ctx, req)
+		if err != nil || len(resp.GetReports()) == 0 {
+			if errors.Is(ctx.Err(), context.Canceled) {
+				return
+			}
+			p.orc.Log(ctx).WithError(err).Error("missing health check response")
+			resp = &healthcheck.GetHealthCheckResponse{
+				Reports: []*healthcheck.HealthCheckReport{report(p.serviceName, p.version, statusDown)},
+			}
+		}
+		code := http.StatusOK
+		for _, rep := range resp.GetReports() {
+			if !strings.EqualFold(rep.GetStatus(), statusUp) {
+				code = http.StatusServiceUnavailable
+				break
+			}
+		}
+		b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(resp)
+		if err != nil {
+			p.orc.Log(ctx).WithError(err).Error("health check response")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		if _, err := w.Write(b); err != nil {
+			p.orc.Log(ctx).WithError(err).Debug("health check write")
+		}
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	healthcheck "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/healthcheck/v1"
+	"github.com/luthersystems/svc/oracle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestGatewayHealthCheck(t *testing.T) {
+	cfg := oracle.DefaultConfig()
+	cfg.GatewayEndpoint = closedEndpoint(t)
+	cfg.ServiceName = "sandbox-oracle"
+	cfg.Version = "1.2.0"
+	orc, err := oracle.NewOracle(cfg)
+	require.NoError(t, err)
+	p := &portal{orc: orc, drain: newDrainer(), serviceName: cfg.ServiceName, version: cfg.Version}
+
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "gateway "+r.URL.Path)
+	}))
+	t.Cleanup(backend.Close)
+	target, err := url.Parse(backend.URL)
+	require.NoError(t, err)
+	server := httptest.NewServer(p.gatewayHandler(target))
+	t.Cleanup(server.Close)
+
+	get := func(path string) (int, []byte) {
+		t.Helper()
+		resp, err := http.Get(server.URL + path)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, b
+	}
+	health := func() (int, map[string]string) {
+		t.Helper()
+		code, b := get(healthCheckPath + "?http_only=true")
+		resp := &healthcheck.GetHealthCheckResponse{}
+		require.NoError(t, protojson.Unmarshal(b, resp), "%s", b)
+		return code, statuses(resp.GetReports())
+	}
+
+	code, b := get("/v1/claims")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "gateway /v1/claims", string(b), "other paths reach the oracle's gateway")
+
+	code, reports := health()
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, map[string]string{"sandbox-oracle": statusUp}, reports)
+
+	p.drain.drain(time.Second)
+	code, reports = health()
+	assert.Equal(t, http.StatusServiceUnavailable, code, "load balancers stop routing to a draining portal")
+	assert.Equal(t, map[string]string{"sandbox-oracle": statusDown}, reports)
+}
@@ -0,0 +1,62 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/luthersystems/svc/grpclogging"
+	"github.com/luthersystems/svc/svcerr"
+	"github.com/luthersystems/svc/txctx"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// serveGRPC serves the portal's gRPC services on addr, for clients such as
+// the portal CLI which call the API directly rather than through the
+// REST/JSON gateway.  Calls pass through the same logging and error handling
+// as gateway requests.  It blocks until ctx is done or the server fails.
+// Calls still in progress when ctx is done are aborted, as the portal has
+// already drained them.
+func (p *portal) serveGRPC(ctx context.Context, addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("grpc listen: %w", err)
+	}
+	server := p.newGRPCServer(ctx)
+	go func() {
+		<-ctx.Done()
+		server.Stop()
+	}()
+	p.orc.Log(ctx).WithField("grpc_listen_address", addr).Info("grpc listen")
+	if err := server.Serve(lis); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+		return fmt.Errorf("grpc serve: %w", err)
+	}
+	return nil
+}
+
+// newGRPCServer returns a server for the portal's gRPC services, with the
+// logging and error handling the oracle applies to gateway requests.  It
+// also serves the standard gRPC health service, which reports NOT_SERVING
+// while the portal drains.
+func (p *portal) newGRPCServer(ctx context.Context) *grpc.Server {
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		grpclogging.LogrusMethodInterceptor(
+			p.orc.Log(ctx),
+			grpclogging.UpperBoundTimer(time.Millisecond),
+			grpclogging.RealTime()),
+		func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			return handler(txctx.Context(ctx), req)
+		},
+		svcerr.AppErrorUnaryInterceptor(p.orc.Log),
+	))
+	p.RegisterServiceServer(server)
+	if p.drain != nil {
+		healthpb.RegisterHealthServer(server, p.drain.health)
+	}
+	return server
+}
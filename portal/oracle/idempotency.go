@@ -0,0 +1,280 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/luthersystems/svc/oracle"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// IdempotencyKeyHeader is the header, or gRPC metadata key, carrying a
+// client chosen key which identifies retries of a mutating request.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// defaultIdempotencyTTL is how long responses are remembered when the
+// portal is not configured otherwise.
+const defaultIdempotencyTTL = 24 * time.Hour
+
+// defaultIdempotencyMaxEntries is how many responses are remembered when the
+// portal is not configured otherwise.
+const defaultIdempotencyMaxEntries = 100000
+
+// idempotencyKeyMaxLen bounds the size of the keys held in memory.
+const idempotencyKeyMaxLen = 255
+
+// idempotencyEntry is the outcome of the first request made with a key.
+type idempotencyEntry struct {
+	key string
+	// digest identifies the request so that a key cannot be reused for a
+	// different request.
+	digest [sha256.Size]byte
+	// done is closed once resp and header are set.
+	done chan struct{}
+	resp any
+	// header is the header metadata set by the call, e.g. the ETag, which
+	// is sent again with replays.
+	header  metadata.MD
+	expires time.Time
+}
+
+// idempotencyStore remembers the responses to mutating requests by
+// idempotency key, so that a retried request is answered with the original
+// response instead of being applied again.  At most maxEntries responses
+// are remembered, evicting the least recently used.
+type idempotencyStore struct {
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+	mu         sync.Mutex
+	entries    map[string]*list.Element
+	// lru holds the entries, most recently used first.
+	lru       *list.List
+	lastSweep time.Time
+}
+
+func newIdempotencyStore(ttl time.Duration, maxEntries int) *idempotencyStore {
+	if ttl <= 0 {
+		ttl = defaultIdempotencyTTL
+	}
+	if maxEntries <= 0 {
+		maxEntries = defaultIdempotencyMaxEntries
+	}
+	return &idempotencyStore{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		now:        time.Now,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+	}
+}
+
+// begin returns the entry for key.  If the key is new, a pending entry is
+// created and first is true: the caller must then complete or abandon it.
+func (s *idempotencyStore) begin(key string, digest [sha256.Size]byte) (e *idempotencyEntry, first bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	s.sweep(now)
+	if el, ok := s.entries[key]; ok {
+		e := el.Value.(*idempotencyEntry)
+		if e.expires.IsZero() || now.Before(e.expires) {
+			s.lru.MoveToFront(el)
+			return e, false
+		}
+		s.remove(el)
+	}
+	e = &idempotencyEntry{key: key, digest: digest, done: make(chan struct{})}
+	s.entries[key] = s.lru.PushFront(e)
+	for s.lru.Len() > s.maxEntries {
+		s.remove(s.lru.Back())
+	}
+	return e, true
+}
+
+// complete records resp, and the header metadata set by the call, as the
+// response to the request which created e.
+func (s *idempotencyStore) complete(e *idempotencyEntry, resp any, header metadata.MD) {
+	s.mu.Lock()
+	e.resp = resp
+	e.header = header
+	e.expires = s.now().Add(s.ttl)
+	s.mu.Unlock()
+	close(e.done)
+}
+
+// abandon forgets e, allowing the request to be retried with the same key.
+// Requests waiting on e retry as well.
+func (s *idempotencyStore) abandon(e *idempotencyEntry) {
+	s.mu.Lock()
+	if el, ok := s.entries[e.key]; ok && el.Value == e {
+		s.remove(el)
+	}
+	s.mu.Unlock()
+	close(e.done)
+}
+
+// remove forgets the entry of el.  The caller must hold s.mu.
+func (s *idempotencyStore) remove(el *list.Element) {
+	delete(s.entries, s.lru.Remove(el).(*idempotencyEntry).key)
+}
+
+// sweep removes expired entries, at most once per minute.  The caller must
+// hold s.mu.
+func (s *idempotencyStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < time.Minute {
+		return
+	}
+	s.lastSweep = now
+	for _, el := range s.entries {
+		if e := el.Value.(*idempotencyEntry); !e.expires.IsZero() && !now.Before(e.expires) {
+			s.remove(el)
+		}
+	}
+}
+
+// headerRecorder records the header metadata set by a call, passing it on
+// to the stream of the call.
+type headerRecorder struct {
+	grpc.ServerTransportStream
+	mu     sync.Mutex
+	header metadata.MD
+}
+
+func (r *headerRecorder) SetHeader(md metadata.MD) error {
+	if err := r.ServerTransportStream.SetHeader(md); err != nil {
+		return err
+	}
+	r.record(md)
+	return nil
+}
+
+func (r *headerRecorder) SendHeader(md metadata.MD) error {
+	if err := r.ServerTransportStream.SendHeader(md); err != nil {
+		return err
+	}
+	r.record(md)
+	return nil
+}
+
+func (r *headerRecorder) record(md metadata.MD) {
+	r.mu.Lock()
+	r.header = metadata.Join(r.header, md)
+	r.mu.Unlock()
+}
+
+// recordHeader returns a context whose calls record the header metadata they
+// set in the returned recorder, which is nil when ctx has no stream.
+func recordHeader(ctx context.Context) (context.Context, *headerRecorder) {
+	stream := grpc.ServerTransportStreamFromContext(ctx)
+	if stream == nil {
+		return ctx, nil
+	}
+	r := &headerRecorder{ServerTransportStream: stream}
+	return grpc.NewContextWithServerTransportStream(ctx, r), r
+}
+
+// mutatingMethods returns the full names of the methods of the service
+// which are not mapped to HTTP GET, i.e. those which change state.
+func mutatingMethods(desc *grpc.ServiceDesc) []string {
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(desc.ServiceName))
+	if err != nil {
+		panic(fmt.Sprintf("service %s: %v", desc.ServiceName, err))
+	}
+	var methods []string
+	for _, m := range desc.Methods {
+		md := d.(protoreflect.ServiceDescriptor).Methods().ByName(protoreflect.Name(m.MethodName))
+		rule, _ := proto.GetExtension(md.Options(), annotations.E_Http).(*annotations.HttpRule)
+		if rule.GetGet() == "" {
+			methods = append(methods, "/"+desc.ServiceName+"/"+m.MethodName)
+		}
+	}
+	return methods
+}
+
+// interceptor answers retries of the given methods which carry an
+// idempotency key with the response to the original request, and the header
+// metadata it set.  Keys are scoped to the caller and method.  Calls which
+// fail, or whose response carries an exception, are not remembered so that
+// they may be retried.  It must run after the interceptors which
+// authenticate the caller.
+func (s *idempotencyStore) interceptor(methods ...string) grpc.UnaryServerInterceptor {
+	idempotent := make(map[string]bool, len(methods))
+	for _, m := range methods {
+		idempotent[m] = true
+	}
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		key := oracle.GetIncomingHeader(ctx, IdempotencyKeyHeader)
+		if key == "" || !idempotent[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		if len(key) > idempotencyKeyMaxLen {
+			return nil, status.Errorf(codes.InvalidArgument, "%s exceeds %d characters", IdempotencyKeyHeader, idempotencyKeyMaxLen)
+		}
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+		b, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "marshal request: %v", err)
+		}
+		var subject string
+		if p := principalFromContext(ctx); p != nil {
+			subject = string(p.kind) + ":" + p.subject
+		}
+		scoped := subject + "\x00" + info.FullMethod + "\x00" + key
+		digest := sha256.Sum256(b)
+		for {
+			e, first := s.begin(scoped, digest)
+			if first {
+				callCtx, recorder := recordHeader(ctx)
+				resp, err := handler(callCtx, req)
+				if err != nil {
+					s.abandon(e)
+					return resp, err
+				}
+				if msg, ok := resp.(proto.Message); !ok || responseException(msg) != nil {
+					s.abandon(e)
+					return resp, nil
+				}
+				var header metadata.MD
+				if recorder != nil {
+					header = recorder.header
+				}
+				s.complete(e, resp, header)
+				return resp, nil
+			}
+			if e.digest != digest {
+				return nil, status.Errorf(codes.InvalidArgument, "%s was used for a different request", IdempotencyKeyHeader)
+			}
+			select {
+			case <-e.done:
+			case <-ctx.Done():
+				return nil, status.FromContextError(ctx.Err()).Err()
+			}
+			if e.resp != nil {
+				if len(e.header) > 0 {
+					if err := grpc.SetHeader(ctx, e.header.Copy()); err != nil {
+						return nil, err
+					}
+				}
+				return proto.Clone(e.resp.(proto.Message)), nil
+			}
+			// The original request failed; retry it.
+		}
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"crypto/sha256"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	srv "github.com/luthersystems/sandbox/api/srvpb/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestMutatingMethods(t *testing.T) {
+	methods := mutatingMethods(&srv.SandboxService_ServiceDesc)
+	assert.Contains(t, methods, srv.SandboxService_CreateClaim_FullMethodName)
+	assert.Contains(t, methods, srv.SandboxService_AddClaimant_FullMethodName)
+	assert.Contains(t, methods, srv.SandboxService_AdvanceClaim_FullMethodName)
+	assert.NotContains(t, methods, srv.SandboxService_GetClaim_FullMethodName)
+	assert.NotContains(t, methods, srv.SandboxService_ListClaims_FullMethodName)
+	assert.NotContains(t, methods, srv.SandboxService_GetHealthCheck_FullMethodName)
+}
+
+func TestIdempotencyInterceptor(t *testing.T) {
+	store := newIdempotencyStore(time.Hour, 0)
+	keys, err := newAPIKeyring("", map[string]string{
+		"frontend":   "key-frontend",
+		"backoffice": "key-backoffice",
+	})
+	require.NoError(t, err)
+	client := newStubClient(t,
+		authInterceptor([]authenticator{keys}, publicMethods...),
+		store.interceptor(mutatingMethods(&srv.SandboxService_ServiceDesc)...))
+	var header metadata.MD
+	create := func(apiKey, idempotencyKey string, req *pb.CreateClaimRequest) (string, error) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), APIKeyHeader, apiKey)
+		if idempotencyKey != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, IdempotencyKeyHeader, idempotencyKey)
+		}
+		resp, err := client.CreateClaim(ctx, req, grpc.Header(&header))
+		return resp.GetClaim().GetClaimId(), err
+	}
+	req := &pb.CreateClaimRequest{ClaimReason: "hail"}
+
+	first, err := create("key-frontend", "retry-1", req)
+	require.NoError(t, err)
+	assert.Equal(t, []string{first}, header.Get(stubETagMetadata))
+	replay, err := create("key-frontend", "retry-1", req)
+	require.NoError(t, err)
+	assert.Equal(t, first, replay)
+	assert.Equal(t, []string{first}, header.Get(stubETagMetadata), "headers are replayed")
+
+	// Without a key, or with another key or caller, the request is applied
+	// again.
+	for name, call := range map[string]func() (string, error){
+		"no key":       func() (string, error) { return create("key-frontend", "", req) },
+		"other key":    func() (string, error) { return create("key-frontend", "retry-2", req) },
+		"other caller": func() (string, error) { return create("key-backoffice", "retry-1", req) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			id, err := call()
+			require.NoError(t, err)
+			assert.NotEqual(t, first, id)
+		})
+	}
+
+	_, err = create("key-frontend", "retry-1", &pb.CreateClaimRequest{ClaimReason: "flood"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "key reused for a different request")
+
+	_, err = create("key-frontend", strings.Repeat("k", idempotencyKeyMaxLen+1), req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "key too long")
+
+	// Failed requests are not remembered.
+	failing := &pb.CreateClaimRequest{ClaimReason: "fail"}
+	_, err = create("key-frontend", "retry-3", failing)
+	require.Error(t, err)
+	_, err = create("key-frontend", "retry-3", failing)
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// Nor are responses carrying an exception.
+	before := stubClaims.Load()
+	for range 2 {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), APIKeyHeader, "key-frontend", IdempotencyKeyHeader, "retry-4")
+		_, err := client.CreateClaim(ctx, &pb.CreateClaimRequest{ClaimReason: "exception"})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	}
+	assert.Equal(t, before+2, stubClaims.Load(), "the request is applied again")
+}
+
+func TestIdempotencyStoreExpiry(t *testing.T) {
+	now := time.Now()
+	store := newIdempotencyStore(time.Hour, 0)
+	store.now = func() time.Time { return now }
+	digest := sha256.Sum256([]byte("req"))
+
+	e, first := store.begin("key", digest)
+	require.True(t, first)
+	store.complete(e, &pb.CreateClaimResponse{}, nil)
+	_, first = store.begin("key", digest)
+	assert.False(t, first)
+
+	now = now.Add(time.Hour)
+	_, first = store.begin("key", digest)
+	assert.True(t, first, "expired entries are replaced")
+	assert.Len(t, store.entries, 1, "expired entries are swept")
+}
+
+func TestIdempotencyStoreEviction(t *testing.T) {
+	store := newIdempotencyStore(time.Hour, 2)
+	digest := sha256.Sum256([]byte("req"))
+	for _, key := range []string{"a", "b"} {
+		e, first := store.begin(key, digest)
+		require.True(t, first)
+		store.complete(e, &pb.CreateClaimResponse{}, nil)
+	}
+
+	// Using a makes b the least recently used, which is evicted by c.
+	_, first := store.begin("a", digest)
+	require.False(t, first)
+	e, first := store.begin("c", digest)
+	require.True(t, first)
+	store.complete(e, &pb.CreateClaimResponse{}, nil)
+	assert.Len(t, store.entries, 2)
+	_, first = store.begin("a", digest)
+	assert.False(t, first, "recently used entries are kept")
+	_, first = store.begin("b", digest)
+	assert.True(t, first, "the least recently used entry is evicted")
+}
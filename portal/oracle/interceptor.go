@@ -0,0 +1,128 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// withInterceptors returns a copy of desc whose unary methods run the given
+// interceptors, in order, inside the interceptors installed on the server by
+// the oracle.  An interceptor may fail a call by returning a nil response; it
+// is replaced by a nil message of the method's response type so that the
+// oracle can render the error as an exception response.  Streaming methods
+// run the interceptors once, with a nil request, before the stream starts.
+func withInterceptors(desc *grpc.ServiceDesc, interceptors ...grpc.UnaryServerInterceptor) *grpc.ServiceDesc {
+	if len(interceptors) == 0 {
+		return desc
+	}
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(desc.ServiceName))
+	if err != nil {
+		panic(fmt.Sprintf("service %s: %v", desc.ServiceName, err))
+	}
+	methods := d.(protoreflect.ServiceDescriptor).Methods()
+	wrapped := *desc
+	wrapped.Methods = make([]grpc.MethodDesc, len(desc.Methods))
+	for i, m := range desc.Methods {
+		output := methods.ByName(protoreflect.Name(m.MethodName)).Output()
+		mt, err := protoregistry.GlobalTypes.FindMessageByName(output.FullName())
+		if err != nil {
+			panic(fmt.Sprintf("method %s: %v", m.MethodName, err))
+		}
+		wrapped.Methods[i] = grpc.MethodDesc{
+			MethodName: m.MethodName,
+			Handler:    interceptedHandler(m.Handler, mt.Zero().Interface(), interceptors),
+		}
+	}
+	wrapped.Streams = make([]grpc.StreamDesc, len(desc.Streams))
+	for i, sd := range desc.Streams {
+		sd.Handler = interceptedStreamHandler(sd.Handler, "/"+desc.ServiceName+"/"+sd.StreamName, interceptors)
+		wrapped.Streams[i] = sd
+	}
+	return &wrapped
+}
+
+// methodHandler is the signature of grpc.MethodDesc handlers.
+type methodHandler = func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error)
+
+func interceptedHandler(handler methodHandler, nilResp any, interceptors []grpc.UnaryServerInterceptor) methodHandler {
+	return func(srv any, ctx context.Context, dec func(any) error, outer grpc.UnaryServerInterceptor) (any, error) {
+		return handler(srv, ctx, dec, func(ctx context.Context, req any, info *grpc.UnaryServerInfo, call grpc.UnaryHandler) (any, error) {
+			inner := func(ctx context.Context, req any) (any, error) {
+				resp, err := chainInterceptors(interceptors, info, call)(ctx, req)
+				if resp == nil {
+					return nilResp, err
+				}
+				return resp, err
+			}
+			if outer == nil {
+				return inner(ctx, req)
+			}
+			return outer(ctx, req, info, inner)
+		})
+	}
+}
+
+func interceptedStreamHandler(handler grpc.StreamHandler, fullMethod string, interceptors []grpc.UnaryServerInterceptor) grpc.StreamHandler {
+	return func(srv any, stream grpc.ServerStream) error {
+		info := &grpc.UnaryServerInfo{Server: srv, FullMethod: fullMethod}
+		_, err := chainInterceptors(interceptors, info, func(ctx context.Context, _ any) (any, error) {
+			return nil, handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+		})(stream.Context(), nil)
+		return err
+	}
+}
+
+// withStreamInterceptors returns a copy of desc whose streaming methods run
+// the given interceptors, in order, before those added by withInterceptors.
+// The oracle installs no stream interceptors on its server, so streams
+// through the gateway see only the interceptors of the service.
+func withStreamInterceptors(desc *grpc.ServiceDesc, interceptors ...grpc.StreamServerInterceptor) *grpc.ServiceDesc {
+	wrapped := *desc
+	wrapped.Streams = make([]grpc.StreamDesc, len(desc.Streams))
+	for i, sd := range desc.Streams {
+		info := &grpc.StreamServerInfo{
+			FullMethod:     "/" + desc.ServiceName + "/" + sd.StreamName,
+			IsClientStream: sd.ClientStreams,
+			IsServerStream: sd.ServerStreams,
+		}
+		handler := sd.Handler
+		for j := len(interceptors) - 1; j >= 0; j-- {
+			next, interceptor := handler, interceptors[j]
+			handler = func(srv any, stream grpc.ServerStream) error {
+				return interceptor(srv, stream, info, next)
+			}
+		}
+		sd.Handler = handler
+		wrapped.Streams[i] = sd
+	}
+	return &wrapped
+}
+
+// contextStream is a server stream whose context carries the values added by
+// interceptors.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// chainInterceptors returns a handler which runs interceptors in order before
+// calling handler.
+func chainInterceptors(interceptors []grpc.UnaryServerInterceptor, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) grpc.UnaryHandler {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		next, interceptor := handler, interceptors[i]
+		handler = func(ctx context.Context, req any) (any, error) {
+			return interceptor(ctx, req, info, next)
+		}
+	}
+	return handler
+}
@@ -0,0 +1,291 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/luthersystems/svc/oracle"
+)
+
+// jwksRefreshInterval is the minimum time between reloads of the JWKS when a
+// token is signed by an unknown key.
+const jwksRefreshInterval = time.Minute
+
+// jwksFetchTimeout bounds the time taken to download a remote JWKS.
+const jwksFetchTimeout = 10 * time.Second
+
+// JWTConfig configures bearer token authentication.
+type JWTConfig struct {
+	// JWKS is the path or http(s) URL of the JSON Web Key Set holding the
+	// token signing keys.  Bearer authentication is disabled when it is not
+	// set.
+	JWKS string
+	// Issuer is the required "iss" claim, if set.
+	Issuer string
+	// Audience is a required member of the "aud" claim, if set.
+	Audience string
+	// ClockSkew is the leeway allowed when checking the time based claims.
+	ClockSkew time.Duration
+	// RolesClaim is the claim listing the caller's roles.
+	RolesClaim string
+}
+
+// jsonWebKey is a public key in a JWKS.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	// RSA keys.
+	N string `json:"n"`
+	E string `json:"e"`
+	// Elliptic curve keys.
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// parseJWKS reads the signing keys in a JSON Web Key Set, by key ID.
+// Encryption keys and keys of unsupported types are skipped.
+func parseJWKS(r io.Reader) (map[string]crypto.PublicKey, error) {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.NewDecoder(r).Decode(&set); err != nil {
+		return nil, fmt.Errorf("decode jwks: %w", err)
+	}
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		var key crypto.PublicKey
+		var err error
+		switch jwk.Kty {
+		case "RSA":
+			key, err = jwk.rsaPublicKey()
+		case "EC":
+			key, err = jwk.ecdsaPublicKey()
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("jwk %q: %w", jwk.Kid, err)
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
+
+func (jwk *jsonWebKey) rsaPublicKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+	if err != nil {
+		return nil, fmt.Errorf("modulus: %w", err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+	if err != nil {
+		return nil, fmt.Errorf("exponent: %w", err)
+	}
+	exp := new(big.Int).SetBytes(e)
+	if len(n) == 0 || !exp.IsInt64() || exp.Int64() < 3 || exp.Int64() > 1<<31-1 {
+		return nil, errors.New("invalid rsa key")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exp.Int64())}, nil
+}
+
+func (jwk *jsonWebKey) ecdsaPublicKey() (*ecdsa.PublicKey, error) {
+	if jwk.Crv != "P-256" {
+		return nil, fmt.Errorf("unsupported curve %q", jwk.Crv)
+	}
+	x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+	if err != nil {
+		return nil, fmt.Errorf("x: %w", err)
+	}
+	y, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+	if err != nil {
+		return nil, fmt.Errorf("y: %w", err)
+	}
+	if len(x) != 32 || len(y) != 32 {
+		return nil, errors.New("invalid P-256 coordinates")
+	}
+	// Check that the point is on the curve.
+	if _, err := ecdh.P256().NewPublicKey(append(append([]byte{4}, x...), y...)); err != nil {
+		return nil, err
+	}
+	return &ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     new(big.Int).SetBytes(x),
+		Y:     new(big.Int).SetBytes(y),
+	}, nil
+}
+
+// jwtVerifier authenticates callers presenting a bearer token signed by one
+// of the keys in a JWKS.
+type jwtVerifier struct {
+	cfg    JWTConfig
+	parser *jwt.Parser
+	client *http.Client
+
+	mu     sync.Mutex
+	keys   map[string]crypto.PublicKey
+	loaded time.Time
+}
+
+// newJWTVerifier returns a verifier for tokens matching cfg, loading the
+// JWKS.
+func newJWTVerifier(ctx context.Context, cfg JWTConfig) (*jwtVerifier, error) {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodES256.Alg()}),
+		jwt.WithLeeway(cfg.ClockSkew),
+		jwt.WithExpirationRequired(),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+	v := &jwtVerifier{
+		cfg:    cfg,
+		parser: jwt.NewParser(opts...),
+		client: &http.Client{Timeout: jwksFetchTimeout},
+	}
+	if err := v.reload(ctx); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// reload reads the JWKS from its file or URL.
+func (v *jwtVerifier) reload(ctx context.Context) error {
+	var r io.ReadCloser
+	if strings.HasPrefix(v.cfg.JWKS, "https://") || strings.HasPrefix(v.cfg.JWKS, "http://") {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.cfg.JWKS, nil)
+		if err != nil {
+			return fmt.Errorf("jwks: %w", err)
+		}
+		resp, err := v.client.Do(req)
+		if err != nil {
+			return fmt.Errorf("jwks: %w", err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return fmt.Errorf("jwks: %s: %s", v.cfg.JWKS, resp.Status)
+		}
+		r = resp.Body
+	} else {
+		f, err := os.Open(v.cfg.JWKS)
+		if err != nil {
+			return fmt.Errorf("jwks: %w", err)
+		}
+		r = f
+	}
+	defer r.Close()
+	keys, err := parseJWKS(r)
+	if err != nil {
+		return fmt.Errorf("jwks %s: %w", v.cfg.JWKS, err)
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.keys = keys
+	v.loaded = time.Now()
+	return nil
+}
+
+// key returns the signing key with the given ID, reloading the JWKS in case
+// the key is new.  Reloads are rate limited by jwksRefreshInterval.
+func (v *jwtVerifier) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	v.mu.Lock()
+	key, ok := v.keys[kid]
+	refresh := !ok && time.Since(v.loaded) >= jwksRefreshInterval
+	if refresh {
+		// Claim the refresh so concurrent requests do not also reload.
+		v.loaded = time.Now()
+	}
+	v.mu.Unlock()
+	if ok {
+		return key, nil
+	}
+	if refresh {
+		if err := v.reload(ctx); err != nil {
+			return nil, err
+		}
+		v.mu.Lock()
+		key, ok = v.keys[kid]
+		v.mu.Unlock()
+		if ok {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// verify checks a token's signature and claims, returning the caller it
+// identifies.
+func (v *jwtVerifier) verify(ctx context.Context, token string) (*principal, error) {
+	claims := jwt.MapClaims{}
+	_, err := v.parser.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return v.key(ctx, kid)
+	})
+	if err != nil {
+		return nil, err
+	}
+	sub, err := claims.GetSubject()
+	if err != nil {
+		return nil, err
+	}
+	if sub == "" {
+		return nil, errors.New("token has no subject")
+	}
+	return &principal{kind: jwtPrincipal, subject: sub, roles: claimStrings(claims[v.cfg.RolesClaim])}, nil
+}
+
+// claimStrings returns the values of a claim holding either a list of strings
+// or a space separated string, as used for OAuth scopes.
+func claimStrings(claim any) []string {
+	switch c := claim.(type) {
+	case string:
+		return strings.Fields(c)
+	case []any:
+		var vals []string
+		for _, v := range c {
+			if s, ok := v.(string); ok {
+				vals = append(vals, s)
+			}
+		}
+		return vals
+	}
+	return nil
+}
+
+// authenticate identifies callers presenting a bearer token in the
+// Authorization header.
+func (v *jwtVerifier) authenticate(ctx context.Context) (*principal, error) {
+	scheme, token, ok := strings.Cut(oracle.GetIncomingHeader(ctx, "authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return nil, nil
+	}
+	p, err := v.verify(ctx, strings.TrimSpace(token))
+	if err != nil {
+		return nil, fmt.Errorf("invalid bearer token: %w", err)
+	}
+	return p, nil
+}
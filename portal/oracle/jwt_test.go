@@ -0,0 +1,216 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testIssuer   = "https://idp.example.com"
+	testAudience = "sandbox"
+)
+
+type testSigner struct {
+	rsaKey *rsa.PrivateKey
+	ecKey  *ecdsa.PrivateKey
+}
+
+func newTestSigner(t *testing.T) *testSigner {
+	t.Helper()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return &testSigner{rsaKey: rsaKey, ecKey: ecKey}
+}
+
+func (s *testSigner) jwks() []byte {
+	b64 := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+	ecPoint := func(i *big.Int) string { return b64(i.FillBytes(make([]byte, 32))) }
+	b, err := json.Marshal(map[string]any{"keys": []map[string]string{
+		{
+			"kty": "RSA", "kid": "rsa-1", "use": "sig",
+			"n": b64(s.rsaKey.N.Bytes()),
+			"e": b64(big.NewInt(int64(s.rsaKey.E)).Bytes()),
+		},
+		{
+			"kty": "EC", "kid": "ec-1", "crv": "P-256",
+			"x": ecPoint(s.ecKey.X), "y": ecPoint(s.ecKey.Y),
+		},
+		{"kty": "oct", "kid": "hmac-1", "k": "c2VjcmV0"},
+	}})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func (s *testSigner) writeJWKS(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, s.jwks(), 0o600))
+	return path
+}
+
+func (s *testSigner) sign(t *testing.T, method jwt.SigningMethod, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+	var key any
+	switch method {
+	case jwt.SigningMethodRS256:
+		key = s.rsaKey
+	case jwt.SigningMethodES256:
+		key = s.ecKey
+	default:
+		key = []byte("secret")
+	}
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func testClaims(mod func(jwt.MapClaims)) jwt.MapClaims {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"sub":   "alice",
+		"iss":   testIssuer,
+		"aud":   []string{testAudience},
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+		"roles": []string{"reader", "writer"},
+	}
+	if mod != nil {
+		mod(claims)
+	}
+	return claims
+}
+
+func testJWTConfig(jwks string) JWTConfig {
+	return JWTConfig{
+		JWKS:       jwks,
+		Issuer:     testIssuer,
+		Audience:   testAudience,
+		ClockSkew:  time.Minute,
+		RolesClaim: "roles",
+	}
+}
+
+func TestJWTVerify(t *testing.T) {
+	signer := newTestSigner(t)
+	ctx := context.Background()
+	v, err := newJWTVerifier(ctx, testJWTConfig(signer.writeJWKS(t)))
+	require.NoError(t, err)
+
+	for _, method := range []jwt.SigningMethod{jwt.SigningMethodRS256, jwt.SigningMethodES256} {
+		kid := map[jwt.SigningMethod]string{jwt.SigningMethodRS256: "rsa-1", jwt.SigningMethodES256: "ec-1"}[method]
+		p, err := v.verify(ctx, signer.sign(t, method, kid, testClaims(nil)))
+		require.NoError(t, err, method.Alg())
+		assert.Equal(t, jwtPrincipal, p.kind)
+		assert.Equal(t, "alice", p.subject)
+		assert.Equal(t, []string{"reader", "writer"}, p.roles)
+	}
+
+	// Tokens which expired within the allowed clock skew are accepted.
+	_, err = v.verify(ctx, signer.sign(t, jwt.SigningMethodRS256, "rsa-1", testClaims(func(c jwt.MapClaims) {
+		c["exp"] = time.Now().Add(-30 * time.Second).Unix()
+	})))
+	require.NoError(t, err)
+
+	for name, token := range map[string]string{
+		"expired": signer.sign(t, jwt.SigningMethodRS256, "rsa-1", testClaims(func(c jwt.MapClaims) {
+			c["exp"] = time.Now().Add(-time.Hour).Unix()
+		})),
+		"not yet valid": signer.sign(t, jwt.SigningMethodRS256, "rsa-1", testClaims(func(c jwt.MapClaims) {
+			c["nbf"] = time.Now().Add(time.Hour).Unix()
+		})),
+		"no expiry": signer.sign(t, jwt.SigningMethodRS256, "rsa-1", testClaims(func(c jwt.MapClaims) {
+			delete(c, "exp")
+		})),
+		"wrong issuer": signer.sign(t, jwt.SigningMethodRS256, "rsa-1", testClaims(func(c jwt.MapClaims) {
+			c["iss"] = "https://evil.example.com"
+		})),
+		"wrong audience": signer.sign(t, jwt.SigningMethodRS256, "rsa-1", testClaims(func(c jwt.MapClaims) {
+			c["aud"] = "other"
+		})),
+		"no subject": signer.sign(t, jwt.SigningMethodRS256, "rsa-1", testClaims(func(c jwt.MapClaims) {
+			delete(c, "sub")
+		})),
+		"unknown key":    signer.sign(t, jwt.SigningMethodRS256, "rsa-2", testClaims(nil)),
+		"mismatched key": signer.sign(t, jwt.SigningMethodES256, "rsa-1", testClaims(nil)),
+		"hmac":           signer.sign(t, jwt.SigningMethodHS256, "hmac-1", testClaims(nil)),
+		"garbage":        "not-a-token",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := v.verify(ctx, token)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestJWTRemoteJWKS(t *testing.T) {
+	signer := newTestSigner(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(signer.jwks())
+	}))
+	t.Cleanup(server.Close)
+	ctx := context.Background()
+	v, err := newJWTVerifier(ctx, testJWTConfig(server.URL))
+	require.NoError(t, err)
+	p, err := v.verify(ctx, signer.sign(t, jwt.SigningMethodES256, "ec-1", testClaims(nil)))
+	require.NoError(t, err)
+	assert.Equal(t, "alice", p.subject)
+}
+
+func TestClaimStrings(t *testing.T) {
+	assert.Equal(t, []string{"a", "b"}, claimStrings("a b"))
+	assert.Equal(t, []string{"a", "b"}, claimStrings([]any{"a", 1, "b"}))
+	assert.Nil(t, claimStrings(nil))
+	assert.Nil(t, claimStrings(42.0))
+}
+
+func TestBearerInterceptor(t *testing.T) {
+	signer := newTestSigner(t)
+	ctx := context.Background()
+	v, err := newJWTVerifier(ctx, testJWTConfig(signer.writeJWKS(t)))
+	require.NoError(t, err)
+	keys, err := newAPIKeyring("", map[string]string{"frontend": "secret"})
+	require.NoError(t, err)
+	client := newStubClient(t, authInterceptor([]authenticator{keys, v}, publicMethods...))
+	req := &pb.GetClaimRequest{ClaimId: testClaimID}
+
+	token := signer.sign(t, jwt.SigningMethodRS256, "rsa-1", testClaims(nil))
+	_, err = client.GetClaim(metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token), req)
+	require.NoError(t, err)
+
+	_, err = client.GetClaim(metadata.AppendToOutgoingContext(ctx, APIKeyHeader, "secret"), req)
+	require.NoError(t, err)
+
+	expired := signer.sign(t, jwt.SigningMethodRS256, "rsa-1", testClaims(func(c jwt.MapClaims) {
+		c["exp"] = time.Now().Add(-time.Hour).Unix()
+	}))
+	_, err = client.GetClaim(metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+expired), req)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,202 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"path"
+	"time"
+
+	common "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/common/v1"
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// metricsPath is the path metrics are served on.
+const metricsPath = "/metrics"
+
+// claimMetricsInterval is how often the claims are counted for the claims
+// gauge.
+const claimMetricsInterval = time.Minute
+
+// The portal's metrics are registered with the default registry, which the
+// oracle serves on :9600 alongside its own metrics.
+var (
+	rpcRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sandbox_rpc_requests_total",
+			Help: "How many API calls completed, partitioned by method and status code.",
+		},
+		[]string{"method", "code"},
+	)
+	rpcDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "sandbox_rpc_duration_seconds",
+			Help:    "Latency of API calls, partitioned by method.",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method"},
+	)
+	exceptions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sandbox_exceptions_total",
+			Help: "How many API calls failed with an exception, partitioned by method and exception type.",
+		},
+		[]string{"method", "type"},
+	)
+	phylumCallDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "sandbox_phylum_call_duration_seconds",
+			Help:    "Latency of calls to the phylum, partitioned by route.",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"route"},
+	)
+	claims = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "sandbox_claims",
+			Help: "How many claims there are, partitioned by state.",
+		},
+		[]string{"state"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(rpcRequests, rpcDuration, exceptions, phylumCallDuration, claims)
+}
+
+// metricsInterceptor counts and times calls, and counts those which fail
+// with an exception by the type of the exception.  Calls are counted by the
+// status code the caller receives, including for exceptions left in the
+// response for svcerr to map.
+func metricsInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		method := path.Base(info.FullMethod)
+		rpcDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+		callErr := err
+		if msg, ok := resp.(proto.Message); ok && err == nil {
+			if ex := responseException(msg); ex != nil {
+				callErr = exceptionError(ctx, ex)
+			}
+		}
+		rpcRequests.WithLabelValues(method, status.Code(callErr).String()).Inc()
+		if t, ok := exceptionType(callErr); ok {
+			exceptions.WithLabelValues(method, t.String()).Inc()
+		}
+		return resp, err
+	}
+}
+
+// exceptionType returns the type of the exception the caller receives for
+// err.  Errors carrying an exception, whether as their detail or within a
+// response, have its type.  Other status errors
+// without detail are converted by svcerr, and have the type it uses for
+// their code.  Errors with some other detail, such as a failed readiness
+// check, are not exceptions.
+func exceptionType(err error) (common.Exception_Type, bool) {
+	if err == nil {
+		return 0, false
+	}
+	stat := status.Convert(err)
+	if len(stat.Details()) > 0 {
+		ex := errorException(err)
+		return ex.GetType(), ex != nil
+	}
+	switch stat.Code() {
+	case codes.InvalidArgument, codes.NotFound, codes.AlreadyExists, codes.FailedPrecondition, codes.OutOfRange:
+		return common.Exception_BUSINESS, true
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return common.Exception_SECURITY_VIOLATION, true
+	case codes.Unavailable, codes.Unimplemented:
+		return common.Exception_SERVICE_NOT_AVAILABLE, true
+	case codes.Aborted, codes.Internal, codes.DataLoss:
+		return common.Exception_INFRASTRUCTURE, true
+	}
+	return common.Exception_UNEXPECTED, true
+}
+
+// observePhylumCall records the duration of a call to the phylum route
+// which started at start.
+func observePhylumCall(route string, start time.Time) {
+	phylumCallDuration.WithLabelValues(route).Observe(time.Since(start).Seconds())
+}
+
+// countClaims returns the number of claims in each state.  Every state is
+// counted, so that states without claims are reported as zero.
+func (p *portal) countClaims(ctx context.Context) (map[pb.ClaimState]int, error) {
+	counts := make(map[pb.ClaimState]int, len(pb.ClaimState_name))
+	for v := range pb.ClaimState_name {
+		counts[pb.ClaimState(v)] = 0
+	}
+	page := &pb.ListClaimsRequest{PageSize: exportPageSize}
+	for {
+		resp, err := p.ListClaims(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+		if ex := resp.GetException(); ex != nil {
+			return nil, fmt.Errorf("list claims: %s", ex.GetDescription())
+		}
+		for _, claim := range resp.GetClaims() {
+			counts[claim.GetState()]++
+		}
+		if resp.GetNextPageToken() == "" {
+			return counts, nil
+		}
+		page.PageToken = resp.GetNextPageToken()
+	}
+}
+
+// watchClaims sets the claims gauge from a count of the claims until ctx is
+// done.
+func (p *portal) watchClaims(ctx context.Context) {
+	ticker := time.NewTicker(claimMetricsInterval)
+	defer ticker.Stop()
+	for {
+		counts, err := p.countClaims(ctx)
+		if err != nil {
+			p.orc.Log(ctx).WithError(err).Warn("metrics: failed to count claims")
+		}
+		for state, n := range counts {
+			claims.WithLabelValues(state.String()).Set(float64(n))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// serveMetrics serves the metrics of the default registry on addr, apart
+// from the API, until ctx is done or the server fails.
+func serveMetrics(ctx context.Context, addr string, log *logrus.Entry) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("metrics listen: %w", err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, promhttp.Handler())
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		_ = server.Close()
+	}()
+	log.WithField("metrics_listen_address", addr).Info("metrics listen")
+	if err := server.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("metrics serve: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	common "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/common/v1"
+	healthcheck "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/healthcheck/v1"
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	srv "github.com/luthersystems/sandbox/api/srvpb/v1"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestExceptionType(t *testing.T) {
+	notReady, err := status.New(codes.Unavailable, "not ready").WithDetails(&healthcheck.GetHealthCheckResponse{})
+	require.NoError(t, err)
+	for name, tc := range map[string]struct {
+		err  error
+		want common.Exception_Type
+		ok   bool
+	}{
+		"ok":              {},
+		"exception":       {err: exceptionError(context.Background(), &common.Exception{Type: common.Exception_BUSINESS}), want: common.Exception_BUSINESS, ok: true},
+		"unauthenticated": {err: status.Error(codes.Unauthenticated, "missing credentials"), want: common.Exception_SECURITY_VIOLATION, ok: true},
+		"draining":        {err: status.Error(codes.Unavailable, "portal is shutting down"), want: common.Exception_SERVICE_NOT_AVAILABLE, ok: true},
+		"unknown":         {err: errors.New("boom"), want: common.Exception_UNEXPECTED, ok: true},
+		"not ready":       {err: notReady.Err()},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, ok := exceptionType(tc.err)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestMetricsInterceptor(t *testing.T) {
+	ctx := context.Background()
+	intercept := metricsInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: srv.SandboxService_GetClaim_FullMethodName}
+	requests := rpcRequests.WithLabelValues("GetClaim", codes.NotFound.String())
+	notFound := exceptions.WithLabelValues("GetClaim", common.Exception_BUSINESS.String())
+	okRequests := rpcRequests.WithLabelValues("GetClaim", codes.OK.String())
+	before, beforeEx, beforeOK := testutil.ToFloat64(requests), testutil.ToFloat64(notFound), testutil.ToFloat64(okRequests)
+
+	ex := &common.Exception{Type: common.Exception_BUSINESS, ExceptionMetadata: map[string]string{notFoundMetadataKey: "claim"}}
+	_, err := intercept(ctx, &pb.GetClaimRequest{}, info, func(context.Context, any) (any, error) {
+		return nil, exceptionError(ctx, ex)
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = intercept(ctx, &pb.GetClaimRequest{}, info, func(context.Context, any) (any, error) {
+		return &pb.GetClaimResponse{}, nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, before+1, testutil.ToFloat64(requests))
+	assert.Equal(t, beforeEx+1, testutil.ToFloat64(notFound))
+	assert.Equal(t, beforeOK+1, testutil.ToFloat64(okRequests))
+}
+
+func TestServeMetrics(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := lis.Addr().String()
+	require.NoError(t, lis.Close())
+
+	observePhylumCall("get_claim", time.Now())
+	ctx, cancel := context.WithCancel(context.Background())
+	errServe := make(chan error, 1)
+	go func() { errServe <- serveMetrics(ctx, addr, logrus.NewEntry(logrus.New())) }()
+
+	var body string
+	require.Eventually(t, func() bool {
+		resp, err := http.Get("http://" + addr + metricsPath)
+		if err != nil {
+			return false
+		}
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		body = string(b)
+		return err == nil && resp.StatusCode == http.StatusOK
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.True(t, strings.Contains(body, `sandbox_phylum_call_duration_seconds_count{route="get_claim"}`), body)
+
+	cancel()
+	assert.NoError(t, <-errServe)
+}
@@ -6,7 +6,9 @@ package oracle
 import (
 	"context"
 	"fmt"
+	"time"
 
+	healthcheck "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/healthcheck/v1"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	srv "github.com/luthersystems/sandbox/api/srvpb/v1"
 	"github.com/luthersystems/svc/oracle"
@@ -16,26 +18,267 @@ import (
 // Config configures the portal.
 type Config struct {
 	oracle.Config
+	// APIKeyFile is a file of named API keys, one "name=key" per line.  The
+	// file is reloaded when it changes.
+	APIKeyFile string
+	// APIKeys are named API keys accepted in addition to those in
+	// APIKeyFile.  API key authentication is disabled when neither is set.
+	APIKeys map[string]string
+	// JWT configures bearer token authentication.
+	JWT JWTConfig
+	// PolicyFile is a YAML authorization policy granting SandboxService
+	// methods to caller roles.  All authenticated callers may call every
+	// method when it is not set.
+	PolicyFile string
+	// IdempotencyTTL is how long responses to mutating requests are
+	// remembered by idempotency key.
+	IdempotencyTTL time.Duration
+	// IdempotencyMaxEntries is how many responses are remembered by
+	// idempotency key, evicting the least recently used.
+	IdempotencyMaxEntries int
+	// GRPCListenAddress is the address on which the gRPC API is served, in
+	// addition to the REST/JSON gateway.  It is not served when empty.
+	GRPCListenAddress string
+	// SnapshotFile is the file from which the state of the emulated phylum
+	// is restored at startup, if it exists, and to which it is saved when
+	// the portal stops.  It requires EmulateCC.
+	SnapshotFile string
+	// SnapshotInterval is how often the state of the emulated phylum is
+	// also saved to SnapshotFile while the portal runs.  The state is only
+	// saved at shutdown when it is zero.
+	SnapshotInterval time.Duration
+	// AdminKey authorizes calls to AdminService, which is only available in
+	// emulated mode.  AdminService is disabled when it is empty.
+	AdminKey string
+	// SeedFile is a YAML or JSON fixture of claims created at startup in
+	// emulated mode.  The fixture is not replayed when the state of the
+	// phylum is restored from SnapshotFile.
+	SeedFile string
+	// DrainTimeout is how long the portal waits, once its context is done,
+	// for calls in progress to complete before it stops.  New calls are
+	// refused and the portal reports that it is not serving meanwhile.
+	DrainTimeout time.Duration
+	// OTLPEndpoint is the URL traces are exported to, if any, whose
+	// exporter is checked for readiness.  Exporting is configured with
+	// SetOTLPEndpoint.
+	OTLPEndpoint string
+	// MetricsListenAddress is an address on which metrics are served at
+	// /metrics, apart from the API.  Metrics are always served by the
+	// oracle on :9600.
+	MetricsListenAddress string
 }
 
 type portal struct {
 	srv.UnimplementedSandboxServiceServer
-	orc *oracle.Oracle
+	orc          *oracle.Oracle
+	interceptors []grpc.UnaryServerInterceptor
+	// etag sets the ETag header of the HTTP response.
+	etag *oracle.HeaderForwarder
+	// emu is the phylum emulated in memory, in emulated mode.
+	emu *emulator
+	// adminKey authorizes calls to AdminService.
+	adminKey string
+	// drain tracks calls in progress, so that they complete before the
+	// portal stops.
+	drain *drainer
+	// requestIDHeader is the header carrying request IDs, as configured for
+	// the oracle.
+	requestIDHeader string
+	// serviceName and version identify the portal in health check reports.
+	serviceName string
+	version     string
+	// phylumServiceName identifies the emulated phylum in health check
+	// reports.
+	phylumServiceName string
+	// readiness checks the dependencies of the portal.
+	readiness *readiness
 }
 
 func (p *portal) RegisterServiceServer(grpcServer *grpc.Server) {
-	srv.RegisterSandboxServiceServer(grpcServer, p)
+	// Exceptions are mapped to status errors after every other interceptor
+	// has seen the response, and before calls are counted.
+	interceptors := append([]grpc.UnaryServerInterceptor{metricsInterceptor(), exceptionInterceptor()}, p.interceptors...)
+	// Streams are given a request ID before any other interceptor runs.
+	streamRequestID := requestIDStreamInterceptor(p.requestIDHeader)
+	sandbox := withInterceptors(&srv.SandboxService_ServiceDesc, interceptors...)
+	grpcServer.RegisterService(withStreamInterceptors(sandbox, streamRequestID), p)
+	// Admin calls are counted, and refused while draining, like any other.
+	admin := []grpc.UnaryServerInterceptor{metricsInterceptor(), requestIDInterceptor(p.requestIDHeader)}
+	if p.drain != nil {
+		admin = append(admin, p.drain.interceptor())
+	}
+	admin = append(admin, p.adminInterceptor(p.adminKey))
+	adminDesc := withInterceptors(&srv.AdminService_ServiceDesc, admin...)
+	grpcServer.RegisterService(withStreamInterceptors(adminDesc, streamRequestID), &adminService{p: p, maxSnapshotSize: maxSnapshotSize})
 }
 
 func (p *portal) RegisterServiceClient(ctx context.Context, grpcConn *grpc.ClientConn, mux *runtime.ServeMux) error {
-	return srv.RegisterSandboxServiceHandlerClient(ctx, mux, srv.NewSandboxServiceClient(grpcConn))
+	if err := srv.RegisterSandboxServiceHandlerClient(ctx, mux, srv.NewSandboxServiceClient(grpcConn)); err != nil {
+		return err
+	}
+	return srv.RegisterAdminServiceHandlerClient(ctx, mux, srv.NewAdminServiceClient(grpcConn))
 }
 
 // Run starts an oracle and blocks the caller until it completes.
 func Run(ctx context.Context, config *Config) error {
-	if orc, err := oracle.NewOracle(&config.Config); err != nil {
+	if config.SnapshotFile != "" && !config.EmulateCC {
+		return fmt.Errorf("snapshot file %s: requires emulated mode", config.SnapshotFile)
+	}
+	var seed []seedClaim
+	if config.SeedFile != "" {
+		if !config.EmulateCC {
+			return fmt.Errorf("seed %s: requires emulated mode", config.SeedFile)
+		}
+		var err error
+		seed, err = loadSeed(config.SeedFile)
+		if err != nil {
+			return err
+		}
+	}
+	p := &portal{drain: newDrainer(), requestIDHeader: config.RequestIDHeader, serviceName: config.ServiceName, version: config.Version, phylumServiceName: config.PhylumServiceName}
+	// Every call has a request ID, even those refused, and calls are
+	// refused before they are authenticated once the portal is draining.
+	p.interceptors = append(p.interceptors, requestIDInterceptor(config.RequestIDHeader), p.drain.interceptor(healthMethods...))
+	var authenticators []authenticator
+	keys, err := newAPIKeyring(config.APIKeyFile, config.APIKeys)
+	if err != nil {
+		return fmt.Errorf("api keys: %w", err)
+	}
+	authAPIKey := config.APIKeyFile != "" || !keys.empty()
+	if authAPIKey {
+		config.ForwardedHeaders = append(config.ForwardedHeaders, APIKeyHeader)
+		authenticators = append(authenticators, keys)
+	}
+	if config.JWT.JWKS != "" {
+		verifier, err := newJWTVerifier(ctx, config.JWT)
+		if err != nil {
+			return err
+		}
+		authenticators = append(authenticators, verifier)
+	}
+	if len(authenticators) > 0 {
+		p.interceptors = append(p.interceptors, authInterceptor(authenticators, publicMethods...))
+	}
+	if config.PolicyFile != "" {
+		if len(authenticators) == 0 {
+			return fmt.Errorf("policy %s: authentication is not configured", config.PolicyFile)
+		}
+		pol, err := loadPolicy(config.PolicyFile)
+		if err != nil {
+			return err
+		}
+		p.interceptors = append(p.interceptors, pol.interceptor(publicMethods...))
+	}
+	config.ForwardedHeaders = append(config.ForwardedHeaders, IdempotencyKeyHeader, IfMatchHeader, AdminKeyHeader)
+	p.adminKey = config.AdminKey
+	p.etag = config.AddHeaderForwarder(ETagHeader)
+	idempotency := newIdempotencyStore(config.IdempotencyTTL, config.IdempotencyMaxEntries)
+	p.interceptors = append(p.interceptors, idempotency.interceptor(mutatingMethods(&srv.SandboxService_ServiceDesc)...))
+	// The portal serves the REST API itself, in front of the oracle's
+	// gateway, so that it can answer health checks while draining.
+	listenAddress := config.ListenAddress
+	config.ListenAddress, err = loopbackAddress()
+	if err != nil {
+		return err
+	}
+	orc, err := oracle.NewOracle(&config.Config)
+	if err != nil {
 		return fmt.Errorf("new oracle: %w", err)
-	} else {
-		return orc.StartGateway(ctx, &portal{orc: orc})
 	}
+	p.orc = orc
+	p.readiness = &readiness{
+		serviceName:  config.ServiceName,
+		version:      config.Version,
+		otlpEndpoint: config.OTLPEndpoint,
+		phylumReports: func(ctx context.Context) ([]*healthcheck.HealthCheckReport, error) {
+			resp, err := p.healthCheck(ctx, &healthcheck.GetHealthCheckRequest{})
+			return resp.GetReports(), err
+		},
+	}
+	if !config.EmulateCC {
+		p.readiness.gatewayEndpoint = config.GatewayEndpoint
+	}
+	if config.OTLPEndpoint != "" {
+		p.readiness.otlpErrors = recordOTelErrors(orc.Log(ctx))
+	}
+	if authAPIKey {
+		go keys.watch(ctx, orc.Log)
+	}
+	if len(authenticators) == 0 {
+		orc.Log(ctx).Warn("authentication disabled")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if config.EmulateCC {
+		// The oracle runs its own emulated phylum, which is left idle.
+		// Calls and health checks reach the portal's phylum instead.
+		var restored bool
+		p.emu, restored, err = startEmulator(config, orc.Log(ctx))
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := p.emu.stop(config.SnapshotFile); err != nil {
+				orc.Log(ctx).WithError(err).Error("failed to stop emulated phylum")
+			}
+		}()
+		if config.SnapshotFile != "" && config.SnapshotInterval > 0 {
+			go p.emu.autoSnapshot(ctx, config.SnapshotFile, config.SnapshotInterval, orc.Log)
+		}
+		if restored && seed != nil {
+			orc.Log(ctx).WithField("seed_file", config.SeedFile).Info("snapshot restored: skipping seed")
+		} else if err := p.seed(ctx, seed); err != nil {
+			return err
+		}
+	}
+	// Requests are served until ctx is done and the calls in progress have
+	// drained, so that stopping does not abort their transactions.
+	serveCtx, stop := context.WithCancel(context.WithoutCancel(ctx))
+	defer stop()
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-serveCtx.Done():
+			return
+		}
+		log := orc.Log(serveCtx).WithField("drain_timeout", config.DrainTimeout)
+		log.Info("draining calls in progress")
+		if n := p.drain.drain(config.DrainTimeout); n > 0 {
+			log.WithField("calls_in_progress", n).Warn("drain timeout: stopping anyway")
+		}
+		stop()
+	}()
+	go p.watchReadiness(serveCtx)
+	go p.watchClaims(serveCtx)
+	servers := []func(context.Context) error{
+		func(ctx context.Context) error {
+			return p.serveGateway(ctx, listenAddress, config.ListenAddress)
+		},
+	}
+	if config.GRPCListenAddress != "" {
+		servers = append(servers, func(ctx context.Context) error {
+			return p.serveGRPC(ctx, config.GRPCListenAddress)
+		})
+	}
+	if config.MetricsListenAddress != "" {
+		servers = append(servers, func(ctx context.Context) error {
+			return serveMetrics(ctx, config.MetricsListenAddress, orc.Log(ctx))
+		})
+	}
+	errServe := make(chan error, len(servers))
+	for _, serve := range servers {
+		go func() {
+			// Failure of any server stops the gateway too.
+			errServe <- serve(serveCtx)
+			stop()
+		}()
+	}
+	err = orc.StartGateway(serveCtx, p)
+	stop()
+	for range servers {
+		if serveErr := <-errServe; err == nil {
+			err = serveErr
+		}
+	}
+	return err
 }
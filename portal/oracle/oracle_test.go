@@ -6,13 +6,19 @@ package oracle
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	healthcheck "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/healthcheck/v1"
 	pb "github.com/luthersystems/sandbox/api/pb/v1"
 	"github.com/luthersystems/svc/oracle"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 // phylumRelPath is the path to the phylum dir relative to this file.
@@ -65,6 +71,48 @@ func getClaim(t *testing.T, server *portal, id string, dst **pb.Claim) bool {
 	return assert.Nil(t, err) && assert.NotNil(t, resp) && assert.Nil(t, resp.GetException())
 }
 
+func TestCreateClaimDetails(t *testing.T) {
+	server, stop := makeTestServer(t)
+	t.Cleanup(stop)
+	resp, err := server.CreateClaim(context.Background(), &pb.CreateClaimRequest{
+		DateOfAccident: "2024-03-01",
+		DamageAmount:   125000,
+		ClaimReason:    "Rear-ended at junction",
+	})
+	require.NoError(t, err)
+	require.Nil(t, resp.GetException())
+	created := resp.GetClaim()
+	require.NotEmpty(t, created.GetClaimId())
+	// New claims are immediately ready for their claimant details.
+	assert.Equal(t, pb.ClaimState_CLAIM_STATE_LOECLAIM_DETAILS_COLLECTED, created.GetState())
+	assert.Equal(t, "2024-03-01", created.GetDateOfAccident())
+	assert.Equal(t, int64(125000), created.GetDamageAmount())
+	assert.Equal(t, "Rear-ended at junction", created.GetClaimReason())
+
+	var claim *pb.Claim
+	require.True(t, getClaim(t, server, created.GetClaimId(), &claim))
+	assert.Equal(t, "2024-03-01", claim.GetDateOfAccident())
+	assert.Equal(t, int64(125000), claim.GetDamageAmount())
+	assert.Equal(t, "Rear-ended at junction", claim.GetClaimReason())
+}
+
+func TestCreateClaimInvalid(t *testing.T) {
+	server, stop := makeTestServer(t)
+	t.Cleanup(stop)
+	for name, req := range map[string]*pb.CreateClaimRequest{
+		"malformed date":  {DateOfAccident: "01/03/2024"},
+		"impossible date": {DateOfAccident: "2024-02-30"},
+		"future date":     {DateOfAccident: time.Now().AddDate(1, 0, 0).Format(time.DateOnly)},
+		"negative amount": {DamageAmount: -1},
+		"long reason":     {ClaimReason: strings.Repeat("x", maxClaimReasonLength+1)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := server.CreateClaim(context.Background(), req)
+			requireInvalid(t, err)
+		})
+	}
+}
+
 func TestHealthCheck(t *testing.T) {
 	server, stop := makeTestServer(t)
 	t.Cleanup(stop)
@@ -83,13 +131,454 @@ func TestGetAccount(t *testing.T) {
 	}
 	var claim *pb.Claim
 	if getClaim(t, server, id, &claim) {
-		resp, err := server.GetClaim(context.Background(), &pb.GetClaimRequest{
+		_, err := server.GetClaim(context.Background(), &pb.GetClaimRequest{
 			ClaimId: "xyz",
 		})
+		requireInvalid(t, err)
+		resp, err := server.GetClaim(context.Background(), &pb.GetClaimRequest{
+			ClaimId: "00000000-0000-4000-8000-000000000000",
+		})
 		if assert.NoError(t, err) {
-			if assert.NotNil(t, resp) {
-				assert.NotNil(t, resp.GetException())
+			if assert.NotNil(t, resp) && assert.NotNil(t, resp.GetException()) {
+				// The gateway responds 404.
+				assert.Equal(t, codes.NotFound, status.Code(exceptionError(context.Background(), resp.GetException())))
 			}
 		}
 	}
 }
+
+func listClaims(t *testing.T, server *portal, req *pb.ListClaimsRequest) *pb.ListClaimsResponse {
+	t.Helper()
+	resp, err := server.ListClaims(context.Background(), req)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Nil(t, resp.GetException())
+	return resp
+}
+
+func TestListClaims(t *testing.T) {
+	server, stop := makeTestServer(t)
+	t.Cleanup(stop)
+	ids := make([]string, 3)
+	for i := range ids {
+		require.True(t, createClaim(t, server, &ids[i]))
+	}
+
+	// Page through all claims in creation order.
+	resp := listClaims(t, server, &pb.ListClaimsRequest{PageSize: 2})
+	require.Len(t, resp.GetClaims(), 2)
+	require.NotEmpty(t, resp.GetNextPageToken())
+	assert.Equal(t, ids[0], resp.GetClaims()[0].GetClaimId())
+	assert.Equal(t, ids[1], resp.GetClaims()[1].GetClaimId())
+	resp = listClaims(t, server, &pb.ListClaimsRequest{
+		PageSize:  2,
+		PageToken: resp.GetNextPageToken(),
+	})
+	require.Len(t, resp.GetClaims(), 1)
+	assert.Equal(t, ids[2], resp.GetClaims()[0].GetClaimId())
+	assert.Empty(t, resp.GetNextPageToken())
+
+	// Filter by state.  New claims are immediately ready for their claimant
+	// details.
+	resp = listClaims(t, server, &pb.ListClaimsRequest{
+		States: []pb.ClaimState{pb.ClaimState_CLAIM_STATE_LOECLAIM_DETAILS_COLLECTED},
+	})
+	assert.Len(t, resp.GetClaims(), 3)
+	resp = listClaims(t, server, &pb.ListClaimsRequest{
+		States: []pb.ClaimState{pb.ClaimState_CLAIM_STATE_LOECLAIM_ID_VERIFIED},
+	})
+	assert.Empty(t, resp.GetClaims())
+	assert.Empty(t, resp.GetNextPageToken())
+
+	// Filter by status.
+	resp = listClaims(t, server, &pb.ListClaimsRequest{
+		Statuses: []pb.Status{pb.Status_STATUS_PAID},
+	})
+	assert.Empty(t, resp.GetClaims())
+}
+
+func TestListClaimsInvalidPageToken(t *testing.T) {
+	server, stop := makeTestServer(t)
+	t.Cleanup(stop)
+	_, err := server.ListClaims(context.Background(), &pb.ListClaimsRequest{
+		PageToken: "not-a-token",
+	})
+	requireInvalid(t, err)
+}
+
+// exportStream collects the claims sent by ExportClaims.
+type exportStream struct {
+	grpc.ServerStream
+	claims []*pb.Claim
+}
+
+func (s *exportStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *exportStream) Send(resp *pb.ExportClaimsResponse) error {
+	s.claims = append(s.claims, resp.GetClaim())
+	return nil
+}
+
+func TestExportClaims(t *testing.T) {
+	server, stop := makeTestServer(t)
+	t.Cleanup(stop)
+	ids := make([]string, 3)
+	for i := range ids {
+		require.True(t, createClaim(t, server, &ids[i]))
+	}
+	_, err := server.AddClaimant(context.Background(), &pb.AddClaimantRequest{
+		ClaimId:  ids[1],
+		Claimant: validClaimant(),
+	})
+	require.NoError(t, err)
+
+	stream := &exportStream{}
+	require.NoError(t, server.ExportClaims(&pb.ExportClaimsRequest{}, stream))
+	require.Len(t, stream.claims, 3)
+	for i, claim := range stream.claims {
+		assert.Equal(t, ids[i], claim.GetClaimId())
+	}
+
+	stream = &exportStream{}
+	require.NoError(t, server.ExportClaims(&pb.ExportClaimsRequest{
+		States: []pb.ClaimState{pb.ClaimState_CLAIM_STATE_LOECLAIM_ID_VERIFIED},
+	}, stream))
+	require.Len(t, stream.claims, 1)
+	assert.Equal(t, ids[1], stream.claims[0].GetClaimId())
+
+	err = server.ExportClaims(&pb.ExportClaimsRequest{
+		States: []pb.ClaimState{pb.ClaimState(99)},
+	}, &exportStream{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func advanceClaim(t *testing.T, server *portal, id string, state pb.ClaimState) *pb.AdvanceClaimResponse {
+	t.Helper()
+	resp, err := server.AdvanceClaim(context.Background(), &pb.AdvanceClaimRequest{
+		ClaimId: id,
+		State:   state,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	return resp
+}
+
+func TestAdvanceClaim(t *testing.T) {
+	server, stop := makeTestServer(t)
+	t.Cleanup(stop)
+	var id string
+	require.True(t, createClaim(t, server, &id))
+
+	// Claims cannot advance until the claimant details are added.
+	resp := advanceClaim(t, server, id, pb.ClaimState_CLAIM_STATE_LOECLAIM_DETAILS_COLLECTED)
+	require.NotNil(t, resp.GetException())
+
+	added, err := server.AddClaimant(context.Background(), &pb.AddClaimantRequest{
+		ClaimId:  id,
+		Claimant: validClaimant(),
+	})
+	require.NoError(t, err)
+	require.Nil(t, added.GetException())
+	require.Equal(t, pb.ClaimState_CLAIM_STATE_LOECLAIM_ID_VERIFIED, added.GetClaim().GetState())
+
+	// Responses for any state other than the current one are rejected.
+	resp = advanceClaim(t, server, id, pb.ClaimState_CLAIM_STATE_OOECLAIM_VALIDATED)
+	require.NotNil(t, resp.GetException())
+
+	for state := pb.ClaimState_CLAIM_STATE_LOECLAIM_ID_VERIFIED; state < pb.ClaimState_CLAIM_STATE_DONE; state++ {
+		resp = advanceClaim(t, server, id, state)
+		require.Nil(t, resp.GetException(), state.String())
+		assert.Equal(t, state+1, resp.GetClaim().GetState())
+	}
+
+	var claim *pb.Claim
+	require.True(t, getClaim(t, server, id, &claim))
+	assert.Equal(t, pb.ClaimState_CLAIM_STATE_DONE, claim.GetState())
+
+	// Completed claims cannot advance any further.
+	resp = advanceClaim(t, server, id, pb.ClaimState_CLAIM_STATE_DONE)
+	require.NotNil(t, resp.GetException())
+
+	resp = advanceClaim(t, server, "00000000-0000-4000-8000-000000000000", pb.ClaimState_CLAIM_STATE_LOECLAIM_ID_VERIFIED)
+	require.NotNil(t, resp.GetException())
+}
+
+func TestGetClaimHistory(t *testing.T) {
+	server, stop := makeTestServer(t)
+	t.Cleanup(stop)
+	var id string
+	require.True(t, createClaim(t, server, &id))
+	ctx := withPrincipal(context.Background(), &principal{kind: apiKeyPrincipal, subject: "backoffice"})
+	added, err := server.AddClaimant(ctx, &pb.AddClaimantRequest{
+		ClaimId:  id,
+		Claimant: validClaimant(),
+	})
+	require.NoError(t, err)
+	require.Nil(t, added.GetException())
+
+	resp, err := server.GetClaimHistory(context.Background(), &pb.GetClaimHistoryRequest{ClaimId: id})
+	require.NoError(t, err)
+	require.Nil(t, resp.GetException())
+	transitions := resp.GetTransitions()
+	require.Len(t, transitions, 2)
+	assert.Equal(t, pb.ClaimState_CLAIM_STATE_NEW, transitions[0].GetFromState())
+	assert.Equal(t, pb.ClaimState_CLAIM_STATE_LOECLAIM_DETAILS_COLLECTED, transitions[0].GetToState())
+	assert.Equal(t, "CLAIMS_PORTAL_UI", transitions[0].GetSystem())
+	assert.Empty(t, transitions[0].GetActor())
+	assert.Equal(t, pb.ClaimState_CLAIM_STATE_LOECLAIM_DETAILS_COLLECTED, transitions[1].GetFromState())
+	assert.Equal(t, pb.ClaimState_CLAIM_STATE_LOECLAIM_ID_VERIFIED, transitions[1].GetToState())
+	assert.Equal(t, "EQUIFAX_ID_VERIFY", transitions[1].GetSystem())
+	assert.Equal(t, "Org1MSP", transitions[1].GetMsp())
+	assert.Equal(t, "backoffice", transitions[1].GetActor())
+	for _, tr := range transitions {
+		assert.NotEmpty(t, tr.GetTimestamp())
+		assert.NotEmpty(t, tr.GetTxId())
+	}
+	assert.NotEqual(t, transitions[0].GetTxId(), transitions[1].GetTxId())
+
+	resp, err = server.GetClaimHistory(context.Background(), &pb.GetClaimHistoryRequest{
+		ClaimId: "00000000-0000-4000-8000-000000000000",
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, resp.GetException())
+}
+
+// newVerifiedClaim creates a claim and adds its claimant, leaving it ready to
+// advance.
+func newVerifiedClaim(t *testing.T, server *portal) string {
+	t.Helper()
+	var id string
+	require.True(t, createClaim(t, server, &id))
+	resp, err := server.AddClaimant(context.Background(), &pb.AddClaimantRequest{
+		ClaimId:  id,
+		Claimant: validClaimant(),
+	})
+	require.NoError(t, err)
+	require.Nil(t, resp.GetException())
+	return id
+}
+
+func setClaimStatus(t *testing.T, server *portal, id string, status pb.Status) *pb.SetClaimStatusResponse {
+	t.Helper()
+	resp, err := server.SetClaimStatus(context.Background(), &pb.SetClaimStatusRequest{
+		ClaimId: id,
+		Status:  status,
+		Reason:  "adjuster decision",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	return resp
+}
+
+func TestSetClaimStatus(t *testing.T) {
+	server, stop := makeTestServer(t)
+	t.Cleanup(stop)
+	id := newVerifiedClaim(t, server)
+
+	_, err := server.SetClaimStatus(context.Background(), &pb.SetClaimStatusRequest{
+		ClaimId: id,
+		Status:  pb.Status_STATUS_APPROVED,
+	})
+	assert.Contains(t, requireInvalid(t, err).GetExceptionMetadata(), "reason")
+
+	resp := setClaimStatus(t, server, id, pb.Status_STATUS_PAID)
+	require.NotNil(t, resp.GetException(), "paid before payment triggered")
+
+	resp = setClaimStatus(t, server, id, pb.Status_STATUS_APPROVED)
+	require.Nil(t, resp.GetException())
+	assert.Equal(t, pb.Status_STATUS_APPROVED, resp.GetClaim().GetStatus())
+	assert.Equal(t, "adjuster decision", resp.GetClaim().GetStatusReason())
+
+	resp = setClaimStatus(t, server, id, pb.Status_STATUS_APPROVED)
+	require.NotNil(t, resp.GetException(), "approved twice")
+
+	for state := pb.ClaimState_CLAIM_STATE_LOECLAIM_ID_VERIFIED; state < pb.ClaimState_CLAIM_STATE_OOEPAY_PAYMENT_TRIGGERED; state++ {
+		require.Nil(t, advanceClaim(t, server, id, state).GetException(), state.String())
+	}
+
+	resp = setClaimStatus(t, server, id, pb.Status_STATUS_DECLINED)
+	require.NotNil(t, resp.GetException(), "declined after payment triggered")
+
+	resp = setClaimStatus(t, server, id, pb.Status_STATUS_PAID)
+	require.Nil(t, resp.GetException())
+	assert.Equal(t, pb.Status_STATUS_PAID, resp.GetClaim().GetStatus())
+
+	for name, status := range map[string]pb.Status{
+		"approved after paid": pb.Status_STATUS_APPROVED,
+		"declined after paid": pb.Status_STATUS_DECLINED,
+		"paid twice":          pb.Status_STATUS_PAID,
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.NotNil(t, setClaimStatus(t, server, id, status).GetException())
+		})
+	}
+}
+
+func TestDeclineClaim(t *testing.T) {
+	server, stop := makeTestServer(t)
+	t.Cleanup(stop)
+	id := newVerifiedClaim(t, server)
+	ctx := context.Background()
+
+	_, err := server.DeclineClaim(ctx, &pb.DeclineClaimRequest{ClaimId: id})
+	assert.Contains(t, requireInvalid(t, err).GetExceptionMetadata(), "reason")
+
+	resp, err := server.DeclineClaim(ctx, &pb.DeclineClaimRequest{ClaimId: id, Reason: "policy lapsed"})
+	require.NoError(t, err)
+	require.Nil(t, resp.GetException())
+	assert.Equal(t, pb.Status_STATUS_DECLINED, resp.GetClaim().GetStatus())
+	assert.Equal(t, "policy lapsed", resp.GetClaim().GetStatusReason())
+	assert.Equal(t, pb.ClaimState_CLAIM_STATE_LOECLAIM_ID_VERIFIED, resp.GetClaim().GetState())
+
+	// Declined claims halt: the workflow cannot advance and the status is
+	// final.
+	assert.NotNil(t, advanceClaim(t, server, id, pb.ClaimState_CLAIM_STATE_LOECLAIM_ID_VERIFIED).GetException())
+	resp, err = server.DeclineClaim(ctx, &pb.DeclineClaimRequest{ClaimId: id, Reason: "again"})
+	require.NoError(t, err)
+	assert.NotNil(t, resp.GetException(), "declined twice")
+	for name, status := range map[string]pb.Status{
+		"approved after declined": pb.Status_STATUS_APPROVED,
+		"paid after declined":     pb.Status_STATUS_PAID,
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.NotNil(t, setClaimStatus(t, server, id, status).GetException())
+		})
+	}
+
+	var claim *pb.Claim
+	require.True(t, getClaim(t, server, id, &claim))
+	assert.Equal(t, pb.ClaimState_CLAIM_STATE_LOECLAIM_ID_VERIFIED, claim.GetState())
+	assert.Equal(t, pb.Status_STATUS_DECLINED, claim.GetStatus())
+
+	// Claimant details cannot be added to a declined claim either.
+	var other string
+	require.True(t, createClaim(t, server, &other))
+	resp, err = server.DeclineClaim(ctx, &pb.DeclineClaimRequest{ClaimId: other, Reason: "duplicate"})
+	require.NoError(t, err)
+	require.Nil(t, resp.GetException())
+	added, err := server.AddClaimant(ctx, &pb.AddClaimantRequest{ClaimId: other, Claimant: validClaimant()})
+	require.NoError(t, err)
+	assert.NotNil(t, added.GetException())
+}
+
+func listClaimEvents(t *testing.T, server *portal, id string) *pb.ListClaimEventsResponse {
+	t.Helper()
+	resp, err := server.ListClaimEvents(context.Background(), &pb.ListClaimEventsRequest{ClaimId: id})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Nil(t, resp.GetException())
+	return resp
+}
+
+func TestListClaimEvents(t *testing.T) {
+	server, stop := makeTestServer(t)
+	t.Cleanup(stop)
+	var id string
+	require.True(t, createClaim(t, server, &id))
+	resp := listClaimEvents(t, server, id)
+	assert.Empty(t, resp.GetOutstanding())
+	assert.Empty(t, resp.GetCompleted())
+
+	added, err := server.AddClaimant(context.Background(), &pb.AddClaimantRequest{
+		ClaimId:  id,
+		Claimant: validClaimant(),
+	})
+	require.NoError(t, err)
+	require.Nil(t, added.GetException())
+	resp = listClaimEvents(t, server, id)
+	require.Len(t, resp.GetOutstanding(), 1)
+	assert.Empty(t, resp.GetCompleted())
+	verify := resp.GetOutstanding()[0]
+	assert.Equal(t, id, verify.GetOid())
+	assert.NotEmpty(t, verify.GetKey())
+	assert.Equal(t, "EQUIFAX_ID_VERIFY", verify.GetSys())
+	assert.Equal(t, "Org1MSP", verify.GetMsp())
+	assert.Equal(t, pb.ClaimState_CLAIM_STATE_LOECLAIM_DETAILS_COLLECTED, verify.GetState())
+	assert.Equal(t, pb.ClaimEventStatus_CLAIM_EVENT_STATUS_OUTSTANDING, verify.GetStatus())
+	assert.NotNil(t, verify.GetReq())
+	assert.NotEmpty(t, verify.GetRaisedAt())
+	assert.Empty(t, verify.GetCompletedAt())
+
+	require.Nil(t, advanceClaim(t, server, id, pb.ClaimState_CLAIM_STATE_LOECLAIM_ID_VERIFIED).GetException())
+	resp = listClaimEvents(t, server, id)
+	require.Len(t, resp.GetCompleted(), 1)
+	assert.Equal(t, verify.GetKey(), resp.GetCompleted()[0].GetKey())
+	assert.Equal(t, pb.ClaimEventStatus_CLAIM_EVENT_STATUS_COMPLETED, resp.GetCompleted()[0].GetStatus())
+	assert.NotEmpty(t, resp.GetCompleted()[0].GetCompletedAt())
+	require.Len(t, resp.GetOutstanding(), 1)
+	assert.Equal(t, "CAMUNDA_WORKFLOW", resp.GetOutstanding()[0].GetSys())
+
+	missing, err := server.ListClaimEvents(context.Background(), &pb.ListClaimEventsRequest{
+		ClaimId: "00000000-0000-4000-8000-000000000000",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(exceptionError(context.Background(), missing.GetException())))
+}
+
+func TestUpdateMissingClaim(t *testing.T) {
+	server, stop := makeTestServer(t)
+	t.Cleanup(stop)
+	ctx := context.Background()
+	id := "00000000-0000-4000-8000-000000000000"
+
+	added, err := server.AddClaimant(ctx, &pb.AddClaimantRequest{ClaimId: id, Claimant: validClaimant()})
+	require.NoError(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(exceptionError(ctx, added.GetException())))
+	advanced, err := server.AdvanceClaim(ctx, &pb.AdvanceClaimRequest{
+		ClaimId: id,
+		State:   pb.ClaimState_CLAIM_STATE_LOECLAIM_ID_VERIFIED,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(exceptionError(ctx, advanced.GetException())))
+	declined, err := server.DeclineClaim(ctx, &pb.DeclineClaimRequest{ClaimId: id, Reason: "fraud"})
+	require.NoError(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(exceptionError(ctx, declined.GetException())))
+}
+
+func TestClaimRevisionConflict(t *testing.T) {
+	server, stop := makeTestServer(t)
+	t.Cleanup(stop)
+	var id string
+	require.True(t, createClaim(t, server, &id))
+	var claim *pb.Claim
+	require.True(t, getClaim(t, server, id, &claim))
+	revision := claim.GetRevision()
+	require.NotZero(t, revision)
+
+	// A stale revision is rejected as a conflict without changing the claim.
+	_, err := server.AddClaimant(context.Background(), &pb.AddClaimantRequest{
+		ClaimId:          id,
+		Claimant:         validClaimant(),
+		ExpectedRevision: revision + 1,
+	})
+	require.Equal(t, codes.Aborted, status.Code(err))
+	require.True(t, getClaim(t, server, id, &claim))
+	assert.Equal(t, revision, claim.GetRevision())
+	assert.Equal(t, pb.ClaimState_CLAIM_STATE_LOECLAIM_DETAILS_COLLECTED, claim.GetState())
+
+	ifMatch := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(IfMatchHeader, revisionETag(revision)))
+	added, err := server.AddClaimant(ifMatch, &pb.AddClaimantRequest{
+		ClaimId:  id,
+		Claimant: validClaimant(),
+	})
+	require.NoError(t, err)
+	require.Nil(t, added.GetException())
+	assert.Greater(t, added.GetClaim().GetRevision(), revision)
+
+	// The claim has moved on, so the old revision now conflicts.
+	_, err = server.SetClaimStatus(ifMatch, &pb.SetClaimStatusRequest{
+		ClaimId: id,
+		Status:  pb.Status_STATUS_APPROVED,
+		Reason:  "adjuster decision",
+	})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	_, err = server.AdvanceClaim(context.Background(), &pb.AdvanceClaimRequest{
+		ClaimId:          id,
+		State:            pb.ClaimState_CLAIM_STATE_LOECLAIM_ID_VERIFIED,
+		ExpectedRevision: revision,
+	})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+}
@@ -0,0 +1,151 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+
+	srv "github.com/luthersystems/sandbox/api/srvpb/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+)
+
+// allMethods is the policy method name granting every method.
+const allMethods = "*"
+
+// policyFile is the YAML representation of an authorization policy.
+//
+//	api_keys:
+//	  frontend: [reader]
+//	  backoffice: [reader, writer]
+//	roles:
+//	  reader: [GetClaim, GetClaimHistory, ListClaims]
+//	  writer: [CreateClaim, AddClaimant, AdvanceClaim]
+type policyFile struct {
+	// APIKeys grants roles to API keys, by key name.
+	APIKeys map[string][]string `yaml:"api_keys"`
+	// Roles lists the SandboxService methods each role may call.
+	Roles map[string][]string `yaml:"roles"`
+}
+
+// policy authorizes calls based on the roles held by the caller.  API keys
+// are granted roles by the policy while bearer tokens assert their own roles.
+type policy struct {
+	apiKeyRoles map[string][]string
+	// grants maps each role to the full names of the methods it may call.
+	grants map[string]map[string]bool
+}
+
+// loadPolicy reads a policy from a YAML file.
+func loadPolicy(path string) (*policy, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("policy: %w", err)
+	}
+	defer f.Close()
+	pol, err := parsePolicy(f)
+	if err != nil {
+		return nil, fmt.Errorf("policy %s: %w", path, err)
+	}
+	return pol, nil
+}
+
+// parsePolicy reads a YAML policy, checking that it only refers to defined
+// roles and existing methods.
+func parsePolicy(r io.Reader) (*policy, error) {
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	var pf policyFile
+	if err := dec.Decode(&pf); err != nil && err != io.EOF {
+		return nil, err
+	}
+	methods := serviceMethods(&srv.SandboxService_ServiceDesc)
+	pol := &policy{
+		apiKeyRoles: pf.APIKeys,
+		grants:      make(map[string]map[string]bool, len(pf.Roles)),
+	}
+	for role, names := range pf.Roles {
+		grant := make(map[string]bool, len(names))
+		for _, name := range names {
+			if name == allMethods {
+				for _, m := range methods {
+					grant[m] = true
+				}
+				continue
+			}
+			m, ok := methods[name]
+			if !ok {
+				return nil, fmt.Errorf("role %q: unknown method %q", role, name)
+			}
+			grant[m] = true
+		}
+		pol.grants[role] = grant
+	}
+	for key, roles := range pf.APIKeys {
+		for _, role := range roles {
+			if _, ok := pol.grants[role]; !ok {
+				return nil, fmt.Errorf("api key %q: unknown role %q", key, role)
+			}
+		}
+	}
+	return pol, nil
+}
+
+// serviceMethods maps the short names of the methods in desc to their full
+// names.
+func serviceMethods(desc *grpc.ServiceDesc) map[string]string {
+	methods := make(map[string]string, len(desc.Methods)+len(desc.Streams))
+	for _, m := range desc.Methods {
+		methods[m.MethodName] = "/" + desc.ServiceName + "/" + m.MethodName
+	}
+	for _, s := range desc.Streams {
+		methods[s.StreamName] = "/" + desc.ServiceName + "/" + s.StreamName
+	}
+	return methods
+}
+
+// roles returns the roles held by p.
+func (pol *policy) roles(p *principal) []string {
+	roles := slices.Clone(p.roles)
+	if p.kind == apiKeyPrincipal {
+		roles = append(roles, pol.apiKeyRoles[p.subject]...)
+	}
+	sort.Strings(roles)
+	return slices.Compact(roles)
+}
+
+// allowed returns true if any of roles may call the method fullMethod.
+func (pol *policy) allowed(roles []string, fullMethod string) bool {
+	for _, role := range roles {
+		if pol.grants[role][fullMethod] {
+			return true
+		}
+	}
+	return false
+}
+
+// interceptor rejects calls from principals whose roles do not allow the
+// method, except for the exempt methods.  It must run after the
+// interceptors which authenticate the caller.
+func (pol *policy) interceptor(exempt ...string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if slices.Contains(exempt, info.FullMethod) {
+			return handler(ctx, req)
+		}
+		p := principalFromContext(ctx)
+		if p == nil {
+			return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+		}
+		if !pol.allowed(pol.roles(p), info.FullMethod) {
+			return nil, status.Errorf(codes.PermissionDenied, "%s is not permitted to call %s", p.subject, info.FullMethod)
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	common "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/common/v1"
+	healthcheck "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/healthcheck/v1"
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	srv "github.com/luthersystems/sandbox/api/srvpb/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testPolicy = `
+api_keys:
+  frontend: [reader]
+  backoffice: [reader, writer]
+  ops: [admin]
+  nobody: []
+roles:
+  reader: [GetClaim, ListClaims, ExportClaims]
+  writer: [CreateClaim, AddClaimant]
+  admin: ["*"]
+`
+
+func TestParsePolicy(t *testing.T) {
+	pol, err := parsePolicy(strings.NewReader(testPolicy))
+	require.NoError(t, err)
+	getClaim := srv.SandboxService_GetClaim_FullMethodName
+	createClaim := srv.SandboxService_CreateClaim_FullMethodName
+
+	frontend := pol.roles(&principal{kind: apiKeyPrincipal, subject: "frontend"})
+	assert.Equal(t, []string{"reader"}, frontend)
+	assert.True(t, pol.allowed(frontend, getClaim))
+	assert.False(t, pol.allowed(frontend, createClaim))
+
+	backoffice := pol.roles(&principal{kind: apiKeyPrincipal, subject: "backoffice"})
+	assert.True(t, pol.allowed(backoffice, getClaim))
+	assert.True(t, pol.allowed(backoffice, createClaim))
+
+	ops := pol.roles(&principal{kind: apiKeyPrincipal, subject: "ops"})
+	assert.True(t, pol.allowed(ops, createClaim))
+
+	assert.Empty(t, pol.roles(&principal{kind: apiKeyPrincipal, subject: "unknown"}))
+
+	for name, content := range map[string]string{
+		"unknown method": "roles:\n  reader: [GetClaims]\n",
+		"unknown role":   "api_keys:\n  frontend: [reader]\n",
+		"unknown field":  "role:\n  reader: [GetClaim]\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := parsePolicy(strings.NewReader(content))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestPolicyInterceptor(t *testing.T) {
+	pol, err := parsePolicy(strings.NewReader(testPolicy))
+	require.NoError(t, err)
+	keys, err := newAPIKeyring("", map[string]string{
+		"frontend": "key-frontend",
+		"nobody":   "key-nobody",
+	})
+	require.NoError(t, err)
+	client := newStubClient(t, authInterceptor([]authenticator{keys}, publicMethods...), pol.interceptor(publicMethods...))
+	ctx := context.Background()
+	withKey := func(key string) context.Context {
+		return metadata.AppendToOutgoingContext(ctx, APIKeyHeader, key)
+	}
+
+	_, err = client.GetClaim(withKey("key-frontend"), &pb.GetClaimRequest{ClaimId: testClaimID})
+	require.NoError(t, err)
+
+	_, err = client.GetHealthCheck(ctx, &healthcheck.GetHealthCheckRequest{})
+	require.NoError(t, err)
+
+	for name, call := range map[string]func() error{
+		"role without method": func() error {
+			_, err := client.CreateClaim(withKey("key-frontend"), &pb.CreateClaimRequest{})
+			return err
+		},
+		"no roles": func() error {
+			_, err := client.GetClaim(withKey("key-nobody"), &pb.GetClaimRequest{ClaimId: testClaimID})
+			return err
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			stat, ok := status.FromError(call())
+			require.True(t, ok)
+			assert.Equal(t, codes.PermissionDenied, stat.Code())
+			require.Len(t, stat.Details(), 1)
+			ex, ok := stat.Details()[0].(*common.Exception)
+			require.True(t, ok)
+			assert.Equal(t, common.Exception_SECURITY_VIOLATION, ex.GetType())
+		})
+	}
+}
+
+func TestPolicyInterceptorStream(t *testing.T) {
+	pol, err := parsePolicy(strings.NewReader(testPolicy))
+	require.NoError(t, err)
+	keys, err := newAPIKeyring("", map[string]string{
+		"frontend": "key-frontend",
+		"nobody":   "key-nobody",
+	})
+	require.NoError(t, err)
+	client := newStubClient(t, authInterceptor([]authenticator{keys}, publicMethods...), pol.interceptor(publicMethods...))
+	export := func(ctx context.Context) (*pb.ExportClaimsResponse, error) {
+		stream, err := client.ExportClaims(ctx, &pb.ExportClaimsRequest{})
+		if err != nil {
+			return nil, err
+		}
+		return stream.Recv()
+	}
+	ctx := context.Background()
+
+	resp, err := export(metadata.AppendToOutgoingContext(ctx, APIKeyHeader, "key-frontend"))
+	require.NoError(t, err)
+	assert.Equal(t, "frontend", resp.GetClaim().GetClaimId())
+
+	_, err = export(metadata.AppendToOutgoingContext(ctx, APIKeyHeader, "key-nobody"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = export(ctx)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
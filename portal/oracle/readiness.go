@@ -0,0 +1,277 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	healthcheck "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/healthcheck/v1"
+	srv "github.com/luthersystems/sandbox/api/srvpb/v1"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// Health check report statuses.
+const (
+	statusUp   = "UP"
+	statusDown = "DOWN"
+	// statusDisabled reports a dependency the portal is not configured to
+	// use.  It does not make the portal unready.
+	statusDisabled = "DISABLED"
+)
+
+// Names of the readiness reports of the portal's dependencies.
+const (
+	gatewayReportName       = "shiroclient-gateway"
+	phylumVersionReportName = "phylum-version"
+	otlpReportName          = "otlp-exporter"
+)
+
+const (
+	// readinessInterval is how often readiness is checked for the gRPC
+	// health service.
+	readinessInterval = 10 * time.Second
+	// dialTimeout limits the checks that dependencies are reachable.
+	dialTimeout = 2 * time.Second
+	// otlpErrorWindow is how long after an export error the OTLP exporter
+	// is reported down.
+	otlpErrorWindow = time.Minute
+)
+
+// readiness checks the dependencies the portal needs to serve requests.
+type readiness struct {
+	serviceName string
+	version     string
+	// gatewayEndpoint is the URL of the shiroclient gateway, or empty in
+	// emulated mode.
+	gatewayEndpoint string
+	// otlpEndpoint is the URL traces are exported to, or empty if tracing
+	// is disabled.
+	otlpEndpoint string
+	otlpErrors   *errorRecorder
+	// phylumReports returns the health reports of the phylum.
+	phylumReports func(ctx context.Context) ([]*healthcheck.HealthCheckReport, error)
+}
+
+// report returns a report on the named service dated now.
+func report(name, version, status string) *healthcheck.HealthCheckReport {
+	return &healthcheck.HealthCheckReport{
+		ServiceName:    name,
+		ServiceVersion: version,
+		Timestamp:      time.Now().Format(time.RFC3339),
+		Status:         status,
+	}
+}
+
+// statusOf returns the status of a check which failed with err.
+func statusOf(err error) string {
+	if err != nil {
+		return statusDown
+	}
+	return statusUp
+}
+
+// ready reports whether none of the reports is down.
+func ready(reports []*healthcheck.HealthCheckReport) bool {
+	for _, r := range reports {
+		if !strings.EqualFold(r.GetStatus(), statusUp) && !strings.EqualFold(r.GetStatus(), statusDisabled) {
+			return false
+		}
+	}
+	return true
+}
+
+// check returns a report on each dependency of the portal, logging the
+// reason for any failed check.
+func (r *readiness) check(ctx context.Context, log *logrus.Entry) []*healthcheck.HealthCheckReport {
+	var reports []*healthcheck.HealthCheckReport
+	if r.gatewayEndpoint == "" {
+		reports = append(reports, report(gatewayReportName, "", statusDisabled))
+	} else {
+		err := dialEndpoint(ctx, r.gatewayEndpoint)
+		if err != nil {
+			log.WithError(err).Warn("readiness: shiroclient gateway unreachable")
+		}
+		reports = append(reports, report(gatewayReportName, "", statusOf(err)))
+	}
+
+	phylum, err := r.phylumReports(ctx)
+	if err != nil {
+		log.WithError(err).Warn("readiness: phylum health check failed")
+	}
+	var phylumVersion string
+	for _, rep := range phylum {
+		if rep.GetServiceName() == r.serviceName {
+			// The oracle's own report is replaced by that of the portal.
+			continue
+		}
+		reports = append(reports, rep)
+		if strings.EqualFold(rep.GetStatus(), statusUp) {
+			phylumVersion = rep.GetServiceVersion()
+		}
+	}
+	reports = append(reports, r.checkPhylumVersion(phylumVersion, log))
+
+	if r.otlpEndpoint == "" {
+		reports = append(reports, report(otlpReportName, "", statusDisabled))
+	} else {
+		err := dialEndpoint(ctx, r.otlpEndpoint)
+		if err == nil {
+			err = r.otlpErrors.since(time.Now().Add(-otlpErrorWindow))
+		}
+		if err != nil {
+			log.WithError(err).Warn("readiness: OTLP exporter failing")
+		}
+		reports = append(reports, report(otlpReportName, "", statusOf(err)))
+	}
+	return reports
+}
+
+// checkPhylumVersion reports whether the phylum, which reports its version
+// as "VERSION (BUILD_ID)", was built for the version of the portal.  The
+// check is disabled for portals built without a version.
+func (r *readiness) checkPhylumVersion(phylumVersion string, log *logrus.Entry) *healthcheck.HealthCheckReport {
+	if r.version == "" {
+		return report(phylumVersionReportName, phylumVersion, statusDisabled)
+	}
+	v, _, _ := strings.Cut(phylumVersion, " (")
+	if v != r.version {
+		log.WithFields(logrus.Fields{
+			"phylum_version": phylumVersion,
+			"portal_version": r.version,
+		}).Warn("readiness: phylum version does not match portal")
+		return report(phylumVersionReportName, phylumVersion, statusDown)
+	}
+	return report(phylumVersionReportName, phylumVersion, statusUp)
+}
+
+// dialEndpoint checks that the host of the endpoint URL accepts TCP
+// connections.
+func dialEndpoint(ctx context.Context, endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("endpoint %s: %w", endpoint, err)
+	}
+	addr := u.Host
+	if u.Port() == "" {
+		port := "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+		addr = net.JoinHostPort(u.Hostname(), port)
+	}
+	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// errorRecorder remembers the last error reported, e.g. by the OTLP
+// exporter.
+type errorRecorder struct {
+	mu   sync.Mutex
+	err  error
+	last time.Time
+}
+
+func (e *errorRecorder) record(err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.err, e.last = err, time.Now()
+}
+
+// since returns the last error if it was reported after t.
+func (e *errorRecorder) since(t time.Time) error {
+	if e == nil {
+		return nil
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.err == nil || e.last.Before(t) {
+		return nil
+	}
+	return e.err
+}
+
+// recordOTelErrors records the errors of OpenTelemetry, such as failed
+// exports, in place of the default handler which only logs them.
+func recordOTelErrors(log *logrus.Entry) *errorRecorder {
+	rec := &errorRecorder{}
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		log.WithError(err).Warn("opentelemetry error")
+		rec.record(err)
+	}))
+	return rec
+}
+
+// GetLiveness reports that the portal is running.
+func (p *portal) GetLiveness(context.Context, *healthcheck.GetHealthCheckRequest) (*healthcheck.GetHealthCheckResponse, error) {
+	return &healthcheck.GetHealthCheckResponse{
+		Reports: []*healthcheck.HealthCheckReport{report(p.serviceName, p.version, statusUp)},
+	}, nil
+}
+
+// readinessReports returns the reports of the portal and its dependencies,
+// and whether the portal is ready to serve requests.
+func (p *portal) readinessReports(ctx context.Context) ([]*healthcheck.HealthCheckReport, bool) {
+	self := report(p.serviceName, p.version, statusUp)
+	if p.drain != nil && p.drain.isDraining() {
+		self.Status = statusDown
+	}
+	reports := []*healthcheck.HealthCheckReport{self}
+	if p.readiness != nil {
+		reports = append(reports, p.readiness.check(ctx, p.orc.Log(ctx))...)
+	}
+	return reports, ready(reports)
+}
+
+// GetReadiness reports whether the portal and its dependencies are ready to
+// serve requests.  When they are not, it fails with Unavailable, carrying
+// the reports as the status detail so that the gateway responds 503 with
+// them as the body.
+func (p *portal) GetReadiness(ctx context.Context, _ *healthcheck.GetHealthCheckRequest) (*healthcheck.GetHealthCheckResponse, error) {
+	reports, ok := p.readinessReports(ctx)
+	resp := &healthcheck.GetHealthCheckResponse{Reports: reports}
+	if ok {
+		return resp, nil
+	}
+	stat, err := status.New(codes.Unavailable, "not ready").WithDetails(resp)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "not ready")
+	}
+	return nil, stat.Err()
+}
+
+// watchReadiness sets the status of the gRPC health service from the
+// readiness of the portal until ctx is done.
+func (p *portal) watchReadiness(ctx context.Context) {
+	ticker := time.NewTicker(readinessInterval)
+	defer ticker.Stop()
+	for {
+		_, ok := p.readinessReports(ctx)
+		serving := healthpb.HealthCheckResponse_SERVING
+		if !ok {
+			serving = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		p.drain.health.SetServingStatus("", serving)
+		p.drain.health.SetServingStatus(srv.SandboxService_ServiceDesc.ServiceName, serving)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	healthcheck "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/healthcheck/v1"
+	"github.com/luthersystems/svc/oracle"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// listenTCP returns the URL of a local endpoint accepting connections.
+func listenTCP(t *testing.T) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = lis.Close() })
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.Close()
+		}
+	}()
+	return "http://" + lis.Addr().String()
+}
+
+// closedEndpoint returns the URL of a local endpoint refusing connections.
+func closedEndpoint(t *testing.T) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := lis.Addr().String()
+	require.NoError(t, lis.Close())
+	return "http://" + addr
+}
+
+func phylumUp(version string) func(context.Context) ([]*healthcheck.HealthCheckReport, error) {
+	return func(context.Context) ([]*healthcheck.HealthCheckReport, error) {
+		return []*healthcheck.HealthCheckReport{
+			report("sandbox", version, statusUp),
+			report("sandbox-oracle", "1.2.0", statusUp),
+		}, nil
+	}
+}
+
+// statuses returns the status of each report by service name.
+func statuses(reports []*healthcheck.HealthCheckReport) map[string]string {
+	m := make(map[string]string, len(reports))
+	for _, r := range reports {
+		m[r.GetServiceName()] = r.GetStatus()
+	}
+	return m
+}
+
+func TestReadinessCheck(t *testing.T) {
+	ctx := context.Background()
+	log := logrus.NewEntry(logrus.New())
+	up, down := listenTCP(t), closedEndpoint(t)
+	for name, tc := range map[string]struct {
+		r     *readiness
+		want  map[string]string
+		ready bool
+	}{
+		"emulated": {
+			r: &readiness{serviceName: "sandbox-oracle", phylumReports: phylumUp("LUTHER_PROJECT_VERSION (LUTHER_PROJECT_BUILD_ID)")},
+			want: map[string]string{
+				gatewayReportName:       statusDisabled,
+				"sandbox":               statusUp,
+				phylumVersionReportName: statusDisabled,
+				otlpReportName:          statusDisabled,
+			},
+			ready: true,
+		},
+		"all up": {
+			r: &readiness{
+				serviceName:     "sandbox-oracle",
+				version:         "1.2.0",
+				gatewayEndpoint: up,
+				otlpEndpoint:    up,
+				otlpErrors:      &errorRecorder{},
+				phylumReports:   phylumUp("1.2.0 (abc123)"),
+			},
+			want: map[string]string{
+				gatewayReportName:       statusUp,
+				"sandbox":               statusUp,
+				phylumVersionReportName: statusUp,
+				otlpReportName:          statusUp,
+			},
+			ready: true,
+		},
+		"gateway unreachable": {
+			r: &readiness{
+				serviceName:     "sandbox-oracle",
+				gatewayEndpoint: down,
+				phylumReports: func(context.Context) ([]*healthcheck.HealthCheckReport, error) {
+					return []*healthcheck.HealthCheckReport{report("sandbox", "", statusDown)}, nil
+				},
+			},
+			want: map[string]string{
+				gatewayReportName:       statusDown,
+				"sandbox":               statusDown,
+				phylumVersionReportName: statusDisabled,
+				otlpReportName:          statusDisabled,
+			},
+		},
+		"version mismatch": {
+			r: &readiness{serviceName: "sandbox-oracle", version: "1.3.0", phylumReports: phylumUp("1.2.0 (abc123)")},
+			want: map[string]string{
+				gatewayReportName:       statusDisabled,
+				"sandbox":               statusUp,
+				phylumVersionReportName: statusDown,
+				otlpReportName:          statusDisabled,
+			},
+		},
+		"otlp failing": {
+			r: func() *readiness {
+				rec := &errorRecorder{}
+				rec.record(errors.New("export failed"))
+				return &readiness{serviceName: "sandbox-oracle", otlpEndpoint: up, otlpErrors: rec, phylumReports: phylumUp("")}
+			}(),
+			want: map[string]string{
+				gatewayReportName:       statusDisabled,
+				"sandbox":               statusUp,
+				phylumVersionReportName: statusDisabled,
+				otlpReportName:          statusDown,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			reports := tc.r.check(ctx, log)
+			assert.Equal(t, tc.want, statuses(reports), "the oracle's own report is dropped")
+			assert.Equal(t, tc.ready, ready(reports))
+		})
+	}
+}
+
+func TestErrorRecorder(t *testing.T) {
+	var nilRec *errorRecorder
+	assert.NoError(t, nilRec.since(time.Time{}))
+	rec := &errorRecorder{}
+	assert.NoError(t, rec.since(time.Time{}))
+	rec.record(errors.New("boom"))
+	assert.Error(t, rec.since(time.Now().Add(-time.Minute)))
+	assert.NoError(t, rec.since(time.Now().Add(time.Minute)), "old errors are forgotten")
+}
+
+func TestGetReadiness(t *testing.T) {
+	ctx := context.Background()
+	cfg := oracle.DefaultConfig()
+	cfg.GatewayEndpoint = closedEndpoint(t)
+	orc, err := oracle.NewOracle(cfg)
+	require.NoError(t, err)
+	p := &portal{orc: orc, drain: newDrainer(), serviceName: "sandbox-oracle", version: "1.2.0"}
+
+	live, err := p.GetLiveness(ctx, &healthcheck.GetHealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"sandbox-oracle": statusUp}, statuses(live.GetReports()))
+
+	resp, err := p.GetReadiness(ctx, &healthcheck.GetHealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"sandbox-oracle": statusUp}, statuses(resp.GetReports()))
+
+	p.readiness = &readiness{serviceName: "sandbox-oracle", version: "1.2.0", phylumReports: phylumUp("1.1.0 (abc123)")}
+	_, err = p.GetReadiness(ctx, &healthcheck.GetHealthCheckRequest{})
+	stat, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Unavailable, stat.Code())
+	require.Len(t, stat.Details(), 1, "the reports are the detail of the error")
+	detail, ok := stat.Details()[0].(*healthcheck.GetHealthCheckResponse)
+	require.True(t, ok)
+	assert.Equal(t, statusDown, statuses(detail.GetReports())[phylumVersionReportName])
+
+	// The portal is not ready while it drains, though it is live.
+	p.readiness.phylumReports = phylumUp("1.2.0 (abc123)")
+	_, err = p.GetReadiness(ctx, &healthcheck.GetHealthCheckRequest{})
+	require.NoError(t, err)
+	p.drain.drain(time.Second)
+	_, err = p.GetReadiness(ctx, &healthcheck.GetHealthCheckRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	_, err = p.GetLiveness(ctx, &healthcheck.GetHealthCheckRequest{})
+	assert.NoError(t, err)
+}
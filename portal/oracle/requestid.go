@@ -0,0 +1,95 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/luthersystems/svc/grpclogging"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// requestIDTransientKey is the transient data key passing the request
+	// ID to the phylum, which includes it in its logs.
+	requestIDTransientKey = "request_id"
+	// requestIDAttribute is the span attribute carrying the request ID,
+	// named as it is by the oracle.
+	requestIDAttribute = "app.request.id"
+)
+
+// requestIDSentKey marks contexts of calls whose request ID has been sent
+// in the response header metadata.
+type requestIDSentKey struct{}
+
+// withRequestID returns a context whose logging fields carry the request ID
+// of the call, and the ID.  The ID is taken from the caller's metadata under
+// header, the request ID header configured for the oracle.  The oracle's
+// logging interceptor only reads x-request-id, and generates an ID for unary
+// calls without one, so the ID the caller sent under header replaces it.
+// Calls it does not see, such as streams, are given an ID in the same way.
+func withRequestID(ctx context.Context, header string) (context.Context, string) {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(header); len(ids) > 0 {
+			id = ids[0]
+		}
+	}
+	logged := grpclogging.ReqID(ctx)
+	if id == "" {
+		id = logged
+	}
+	if id == "" {
+		id = uuid.New().String()
+	}
+	if id == logged {
+		return ctx, id
+	}
+	if len(grpclogging.GetLogrusFields(ctx)) == 0 {
+		ctx = grpclogging.NewContext(ctx)
+	}
+	grpclogging.AddLogrusField(ctx, "req_id", id)
+	return ctx, id
+}
+
+// requestIDHeader returns the response header metadata carrying id under
+// header.
+func requestIDHeader(header, id string) metadata.MD {
+	return metadata.Pairs(strings.ToLower(header), id)
+}
+
+// requestIDInterceptor makes sure every call has a request ID, and returns
+// it to gRPC callers in the response header metadata, unless
+// requestIDStreamInterceptor already has.  The oracle also sends the ID of
+// gateway requests in the HTTP response.
+func requestIDInterceptor(header string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, id := withRequestID(ctx, header)
+		trace.SpanFromContext(ctx).SetAttributes(attribute.String(requestIDAttribute, id))
+		if ctx.Value(requestIDSentKey{}) == nil {
+			// There is no header to set on calls made other than through a
+			// gRPC server.
+			_ = grpc.SetHeader(ctx, requestIDHeader(header, id))
+		}
+		return handler(ctx, req)
+	}
+}
+
+// requestIDStreamInterceptor is requestIDInterceptor for streaming calls,
+// which the oracle's logging interceptor does not see.
+func requestIDStreamInterceptor(header string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, id := withRequestID(ss.Context(), header)
+		trace.SpanFromContext(ctx).SetAttributes(attribute.String(requestIDAttribute, id))
+		if err := ss.SetHeader(requestIDHeader(header, id)); err != nil {
+			return err
+		}
+		ctx = context.WithValue(ctx, requestIDSentKey{}, true)
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
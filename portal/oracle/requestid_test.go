@@ -0,0 +1,119 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"testing"
+
+	srv "github.com/luthersystems/sandbox/api/srvpb/v1"
+	"github.com/luthersystems/svc/grpclogging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// testRequestIDHeader is a request ID header other than the oracle's
+// default, as it may be configured.
+const testRequestIDHeader = "X-Correlation-ID"
+
+// headerStream records the header metadata set by a call.
+type headerStream struct {
+	header metadata.MD
+}
+
+func (s *headerStream) Method() string { return srv.SandboxService_GetClaim_FullMethodName }
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *headerStream) SendHeader(md metadata.MD) error { return s.SetHeader(md) }
+
+func (s *headerStream) SetTrailer(metadata.MD) error { return nil }
+
+func TestRequestIDInterceptor(t *testing.T) {
+	intercept := requestIDInterceptor(testRequestIDHeader)
+	info := &grpc.UnaryServerInfo{FullMethod: srv.SandboxService_GetClaim_FullMethodName}
+	call := func(ctx context.Context) (string, metadata.MD) {
+		stream := &headerStream{}
+		ctx = grpc.NewContextWithServerTransportStream(ctx, stream)
+		var id string
+		_, err := intercept(ctx, nil, info, func(ctx context.Context, _ any) (any, error) {
+			id = grpclogging.ReqID(ctx)
+			return "ok", nil
+		})
+		require.NoError(t, err)
+		return id, stream.header
+	}
+
+	// The ID accepted or generated by the oracle's logging interceptor is
+	// returned to the caller.
+	ctx := grpclogging.NewContext(context.Background())
+	grpclogging.AddLogrusField(ctx, "req_id", "caller-id")
+	id, header := call(ctx)
+	assert.Equal(t, "caller-id", id)
+	assert.Equal(t, []string{"caller-id"}, header.Get(testRequestIDHeader))
+
+	// The ID the caller sent under the configured header replaces the one
+	// the oracle generated, since the oracle only reads x-request-id.
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(testRequestIDHeader, "correlation-id"))
+	id, header = call(ctx)
+	assert.Equal(t, "correlation-id", id)
+	assert.Equal(t, []string{"correlation-id"}, header.Get(testRequestIDHeader))
+
+	// Calls without one are given an ID.
+	id, header = call(context.Background())
+	assert.NotEmpty(t, id)
+	assert.Equal(t, []string{id}, header.Get(testRequestIDHeader))
+}
+
+// headerServerStream is a server stream which records its header metadata.
+type headerServerStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	header metadata.MD
+}
+
+func (s *headerServerStream) Context() context.Context { return s.ctx }
+
+func (s *headerServerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestRequestIDStreamInterceptor(t *testing.T) {
+	intercept := requestIDStreamInterceptor(testRequestIDHeader)
+	unary := requestIDInterceptor(testRequestIDHeader)
+	info := &grpc.StreamServerInfo{FullMethod: srv.SandboxService_ExportClaims_FullMethodName, IsServerStream: true}
+	call := func(ctx context.Context) (string, metadata.MD) {
+		transport := &headerStream{}
+		stream := &headerServerStream{ctx: grpc.NewContextWithServerTransportStream(ctx, transport), header: metadata.MD{}}
+		var id string
+		err := intercept(nil, stream, info, func(_ any, ss grpc.ServerStream) error {
+			// The unary interceptors run by withInterceptors see the same
+			// ID, and do not send it again.
+			_, err := unary(ss.Context(), nil, &grpc.UnaryServerInfo{FullMethod: info.FullMethod}, func(ctx context.Context, _ any) (any, error) {
+				id = grpclogging.ReqID(ctx)
+				return nil, nil
+			})
+			return err
+		})
+		require.NoError(t, err)
+		assert.Empty(t, transport.header, "the ID is sent once")
+		return id, stream.header
+	}
+
+	// Streams accept the caller's ID from their metadata.
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(testRequestIDHeader, "caller-id"))
+	id, header := call(ctx)
+	assert.Equal(t, "caller-id", id)
+	assert.Equal(t, []string{"caller-id"}, header.Get(testRequestIDHeader))
+
+	// Streams without one are given an ID.
+	id, header = call(context.Background())
+	assert.NotEmpty(t, id)
+	assert.Equal(t, []string{id}, header.Get(testRequestIDHeader))
+}
@@ -0,0 +1,119 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	common "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/common/v1"
+	"github.com/luthersystems/svc/oracle"
+	"github.com/luthersystems/svc/svcerr"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// IfMatchHeader carries the ETag of the claim revision a mutating
+	// request expects, as an alternative to its expected_revision field.
+	IfMatchHeader = "If-Match"
+	// ETagHeader is the response header carrying the revision of the
+	// returned claim.
+	ETagHeader = "ETag"
+)
+
+// conflictMetadataKey marks exceptions raised by the phylum for revision
+// conflicts.
+const conflictMetadataKey = "conflict"
+
+// revisionETag formats a claim revision as an ETag.
+func revisionETag(revision int64) string {
+	return strconv.Quote(strconv.FormatInt(revision, 10))
+}
+
+// parseRevisionETag parses an ETag returned by revisionETag.  Weak ETags
+// are accepted since revisions identify the claim semantically.
+func parseRevisionETag(etag string) (int64, error) {
+	s := strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+	revision, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || revision <= 0 {
+		return 0, fmt.Errorf("invalid %s %q: expected a claim revision ETag", IfMatchHeader, etag)
+	}
+	return revision, nil
+}
+
+// expectedRevisionField returns the expected_revision field of req, or nil
+// if req is not a mutating claim request.
+func expectedRevisionField(req proto.Message) protoreflect.FieldDescriptor {
+	fd := req.ProtoReflect().Descriptor().Fields().ByName("expected_revision")
+	if fd == nil || fd.Kind() != protoreflect.Int64Kind {
+		return nil
+	}
+	return fd
+}
+
+// applyIfMatch sets the expected revision of req from the If-Match header,
+// if present.  A business exception is returned if the header is malformed,
+// is sent with a request that has no expected revision, or disagrees with
+// the revision in the request body.
+func applyIfMatch(ctx context.Context, req proto.Message) *common.Exception {
+	etag := oracle.GetIncomingHeader(ctx, IfMatchHeader)
+	if etag == "" {
+		return nil
+	}
+	fd := expectedRevisionField(req)
+	if fd == nil {
+		return svcerr.BusinessException(ctx, fmt.Sprintf("%s is not supported by this request", IfMatchHeader))
+	}
+	revision, err := parseRevisionETag(etag)
+	if err != nil {
+		return svcerr.BusinessException(ctx, err.Error())
+	}
+	m := req.ProtoReflect()
+	if current := m.Get(fd).Int(); current != 0 && current != revision {
+		return svcerr.BusinessException(ctx, fmt.Sprintf("%s %s does not match expected_revision %d", IfMatchHeader, etag, current))
+	}
+	m.Set(fd, protoreflect.ValueOfInt64(revision))
+	return nil
+}
+
+// responseException returns the exception set on resp, if any.
+func responseException(resp proto.Message) *common.Exception {
+	m := resp.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName("exception")
+	if fd == nil || !m.Has(fd) {
+		return nil
+	}
+	ex, _ := m.Get(fd).Message().Interface().(*common.Exception)
+	return ex
+}
+
+// conflictError returns an Aborted status carrying ex if ex reports a
+// revision conflict, which the gateway renders as HTTP 409.
+func conflictError(ctx context.Context, ex *common.Exception) error {
+	if ex.GetExceptionMetadata()[conflictMetadataKey] == "" {
+		return nil
+	}
+	return exceptionError(ctx, ex)
+}
+
+// claimRevision returns the revision of the claim returned in resp, or zero
+// if resp carries no claim.
+func claimRevision(resp proto.Message) int64 {
+	m := resp.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName("claim")
+	if fd == nil || fd.Kind() != protoreflect.MessageKind || !m.Has(fd) {
+		return 0
+	}
+	claim := m.Get(fd).Message()
+	rev := claim.Descriptor().Fields().ByName("revision")
+	if rev == nil {
+		return 0
+	}
+	return claim.Get(rev).Int()
+}
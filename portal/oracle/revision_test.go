@@ -0,0 +1,95 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"testing"
+
+	common "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/common/v1"
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseRevisionETag(t *testing.T) {
+	for etag, want := range map[string]int64{
+		`"3"`:   3,
+		`W/"3"`: 3,
+		`12`:    12,
+	} {
+		got, err := parseRevisionETag(etag)
+		require.NoError(t, err, etag)
+		assert.Equal(t, want, got, etag)
+	}
+	for _, etag := range []string{`"0"`, `"-1"`, `"abc"`, `*`, `""`} {
+		_, err := parseRevisionETag(etag)
+		assert.Error(t, err, etag)
+	}
+	rev, err := parseRevisionETag(revisionETag(42))
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), rev)
+}
+
+func TestApplyIfMatch(t *testing.T) {
+	withIfMatch := func(etag string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(IfMatchHeader, etag))
+	}
+
+	req := &pb.AddClaimantRequest{ClaimId: testClaimID}
+	require.Nil(t, applyIfMatch(context.Background(), req))
+	assert.Zero(t, req.GetExpectedRevision())
+
+	require.Nil(t, applyIfMatch(withIfMatch(`"3"`), req))
+	assert.Equal(t, int64(3), req.GetExpectedRevision())
+	require.Nil(t, applyIfMatch(withIfMatch(`"3"`), req), "header agrees with body")
+
+	for name, call := range map[string]func() *common.Exception{
+		"malformed": func() *common.Exception {
+			return applyIfMatch(withIfMatch("nope"), &pb.AddClaimantRequest{})
+		},
+		"disagrees with body": func() *common.Exception {
+			return applyIfMatch(withIfMatch(`"4"`), &pb.AddClaimantRequest{ExpectedRevision: 3})
+		},
+		"unsupported request": func() *common.Exception {
+			return applyIfMatch(withIfMatch(`"4"`), &pb.CreateClaimRequest{})
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ex := call()
+			require.NotNil(t, ex)
+			assert.Equal(t, common.Exception_BUSINESS, ex.GetType())
+		})
+	}
+}
+
+func TestConflictError(t *testing.T) {
+	ctx := context.Background()
+	assert.NoError(t, conflictError(ctx, nil))
+	assert.NoError(t, conflictError(ctx, &common.Exception{Type: common.Exception_BUSINESS}))
+
+	ex := &common.Exception{
+		Type:              common.Exception_BUSINESS,
+		Description:       "stale",
+		ExceptionMetadata: map[string]string{conflictMetadataKey: "revision", "revision": "4"},
+	}
+	err := conflictError(ctx, responseException(&pb.AddClaimantResponse{
+		Result: &pb.AddClaimantResponse_Exception{Exception: ex},
+	}))
+	stat, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Aborted, stat.Code())
+	require.Len(t, stat.Details(), 1)
+	assert.Equal(t, "4", stat.Details()[0].(*common.Exception).GetExceptionMetadata()["revision"])
+}
+
+func TestClaimRevision(t *testing.T) {
+	assert.Zero(t, claimRevision(&pb.GetClaimResponse{}))
+	assert.Zero(t, claimRevision(&pb.ListClaimsResponse{}))
+	assert.Equal(t, int64(7), claimRevision(&pb.GetClaimResponse{
+		Result: &pb.GetClaimResponse_Claim{Claim: &pb.Claim{Revision: 7}},
+	}))
+}
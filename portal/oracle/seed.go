@@ -0,0 +1,164 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	common "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/common/v1"
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// seedClaimantField is the field of a fixture claim holding its claimant.
+const seedClaimantField = "claimant"
+
+// seedFile is the YAML, or JSON, representation of a fixture.  Each claim
+// holds the fields of CreateClaimRequest and, optionally, the Claimant added
+// to it, in their protojson form.
+//
+//	claims:
+//	  - dateOfAccident: "2024-05-01"
+//	    damageAmount: 125000
+//	    claimReason: Rear-ended at a junction
+//	    claimant:
+//	      forename: Alice
+//	      surname: Smith
+type seedFile struct {
+	Claims []yaml.Node `yaml:"claims"`
+}
+
+// seedClaim is a claim to create when seeding the portal.
+type seedClaim struct {
+	claim *pb.CreateClaimRequest
+	// claimant is added to the claim if it is not nil.
+	claimant *pb.Claimant
+}
+
+// loadSeed reads a fixture from a YAML or JSON file.
+func loadSeed(path string) ([]seedClaim, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("seed: %w", err)
+	}
+	defer f.Close()
+	claims, err := parseSeed(f)
+	if err != nil {
+		return nil, fmt.Errorf("seed %s: %w", path, err)
+	}
+	return claims, nil
+}
+
+// parseSeed reads a YAML or JSON fixture.
+func parseSeed(r io.Reader) ([]seedClaim, error) {
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	var sf seedFile
+	if err := dec.Decode(&sf); err != nil && err != io.EOF {
+		return nil, err
+	}
+	claims := make([]seedClaim, 0, len(sf.Claims))
+	for i := range sf.Claims {
+		fields, ok := seedValue(&sf.Claims[i]).(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("claim %d: expected a mapping", i+1)
+		}
+		var c seedClaim
+		if claimant, ok := fields[seedClaimantField]; ok {
+			delete(fields, seedClaimantField)
+			c.claimant = &pb.Claimant{}
+			if err := unmarshalSeed(claimant, c.claimant); err != nil {
+				return nil, fmt.Errorf("claim %d: claimant: %w", i+1, err)
+			}
+		}
+		c.claim = &pb.CreateClaimRequest{}
+		if err := unmarshalSeed(fields, c.claim); err != nil {
+			return nil, fmt.Errorf("claim %d: %w", i+1, err)
+		}
+		claims = append(claims, c)
+	}
+	return claims, nil
+}
+
+// seedValue returns the value of a YAML node in the form decoded from JSON.
+// Unlike decoding into an interface, dates such as 2024-05-01 are kept as
+// strings, as the pb.v1 types hold them.
+func seedValue(n *yaml.Node) any {
+	switch n.Kind {
+	case yaml.DocumentNode:
+		if len(n.Content) == 0 {
+			return nil
+		}
+		return seedValue(n.Content[0])
+	case yaml.AliasNode:
+		return seedValue(n.Alias)
+	case yaml.SequenceNode:
+		v := make([]any, len(n.Content))
+		for i, c := range n.Content {
+			v[i] = seedValue(c)
+		}
+		return v
+	case yaml.MappingNode:
+		v := make(map[string]any, len(n.Content)/2)
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			v[n.Content[i].Value] = seedValue(n.Content[i+1])
+		}
+		return v
+	}
+	if n.ShortTag() == "!!timestamp" {
+		return n.Value
+	}
+	var v any
+	if err := n.Decode(&v); err != nil {
+		return n.Value
+	}
+	return v
+}
+
+// unmarshalSeed reads a message from its decoded protojson form.
+func unmarshalSeed(v any, m proto.Message) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return protojson.Unmarshal(b, m)
+}
+
+// seed creates the fixture's claims through the portal's own methods, so
+// that they are validated exactly as API requests are.
+func (p *portal) seed(ctx context.Context, claims []seedClaim) error {
+	for i, c := range claims {
+		created, err := p.CreateClaim(ctx, c.claim)
+		if err != nil {
+			return fmt.Errorf("seed claim %d: %w", i+1, err)
+		}
+		if err := seedException(i, created.GetException()); err != nil {
+			return err
+		}
+		id := created.GetClaim().GetClaimId()
+		if c.claimant != nil {
+			added, err := p.AddClaimant(ctx, &pb.AddClaimantRequest{ClaimId: id, Claimant: c.claimant})
+			if err != nil {
+				return fmt.Errorf("seed claim %d: %w", i+1, err)
+			}
+			if err := seedException(i, added.GetException()); err != nil {
+				return err
+			}
+		}
+		p.orc.Log(ctx).WithField("claim_id", id).Info("seeded claim")
+	}
+	return nil
+}
+
+func seedException(i int, ex *common.Exception) error {
+	if ex == nil {
+		return nil
+	}
+	return fmt.Errorf("seed claim %d: %s", i+1, ex.GetDescription())
+}
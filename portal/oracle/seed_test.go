@@ -0,0 +1,87 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+const testSeed = `
+claims:
+  - dateOfAccident: 2024-05-01
+    damageAmount: 125000
+    claimReason: Rear-ended at a junction
+    claimant:
+      forename: Alice
+      surname: Smith
+      dob: 1990-01-31
+  - claim_reason: Hail damage
+`
+
+func TestParseSeed(t *testing.T) {
+	claims, err := parseSeed(strings.NewReader(testSeed))
+	require.NoError(t, err)
+	require.Len(t, claims, 2)
+	assert.True(t, proto.Equal(&pb.CreateClaimRequest{
+		DateOfAccident: "2024-05-01",
+		DamageAmount:   125000,
+		ClaimReason:    "Rear-ended at a junction",
+	}, claims[0].claim))
+	assert.True(t, proto.Equal(&pb.Claimant{Forename: "Alice", Surname: "Smith", Dob: "1990-01-31"}, claims[0].claimant))
+	assert.True(t, proto.Equal(&pb.CreateClaimRequest{ClaimReason: "Hail damage"}, claims[1].claim))
+	assert.Nil(t, claims[1].claimant)
+
+	// JSON fixtures are YAML too.
+	claims, err = parseSeed(strings.NewReader(`{"claims": [{"damageAmount": "42"}]}`))
+	require.NoError(t, err)
+	require.Len(t, claims, 1)
+	assert.Equal(t, int64(42), claims[0].claim.GetDamageAmount())
+
+	claims, err = parseSeed(strings.NewReader(""))
+	require.NoError(t, err)
+	assert.Empty(t, claims)
+
+	for name, seed := range map[string]string{
+		"unknown section":  "accounts: []",
+		"not a mapping":    "claims: [42]",
+		"unknown field":    "claims: [{colour: red}]",
+		"unknown claimant": "claims: [{claimant: {shoe_size: 9}}]",
+		"wrong type":       "claims: [{damageAmount: lots}]",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseSeed(strings.NewReader(seed))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSeed(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStandalone(t, nil)
+	claims, err := parseSeed(strings.NewReader(testSeed))
+	require.NoError(t, err)
+	require.NoError(t, s.p.seed(ctx, claims))
+
+	list, err := s.Client().ListClaims(ctx, &pb.ListClaimsRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.GetClaims(), 2)
+	var surnames []string
+	for _, c := range list.GetClaims() {
+		surnames = append(surnames, c.GetClaimant().GetSurname())
+	}
+	assert.ElementsMatch(t, []string{"Smith", ""}, surnames)
+
+	// Invalid claims are reported with their position in the fixture.
+	claims, err = parseSeed(strings.NewReader("claims: [{}, {dateOfAccident: not-a-date}]"))
+	require.NoError(t, err)
+	err = s.p.seed(ctx, claims)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "seed claim 2")
+}
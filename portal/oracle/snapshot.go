@@ -0,0 +1,97 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// openSnapshotFile opens the snapshot at path, returning nil if it does not
+// exist yet.
+func openSnapshotFile(path string) (*os.File, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("snapshot: %w", err)
+	}
+	return f, nil
+}
+
+// writeSnapshotFile replaces the file at path with the snapshot written by
+// snapshot.  The snapshot is written to a temporary file first, so that path
+// always holds a complete snapshot.
+func writeSnapshotFile(path string, snapshot func(io.Writer) error) error {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("snapshot: %w", err)
+	}
+	defer os.Remove(f.Name())
+	if err := snapshot(f); err != nil {
+		f.Close()
+		return fmt.Errorf("snapshot: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("snapshot: %w", err)
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return fmt.Errorf("snapshot: %w", err)
+	}
+	return nil
+}
+
+// startEmulator starts the emulated phylum for config, restoring it from the
+// snapshot file if one is configured and exists.  It reports whether the
+// phylum was restored.
+func startEmulator(config *Config, log *logrus.Entry) (*emulator, bool, error) {
+	var snapshot io.Reader
+	if config.SnapshotFile != "" {
+		f, err := openSnapshotFile(config.SnapshotFile)
+		if err != nil {
+			return nil, false, err
+		}
+		if f != nil {
+			defer f.Close()
+			snapshot = f
+			log = log.WithField("snapshot_file", config.SnapshotFile)
+			log.Info("restoring snapshot")
+		}
+	}
+	emu, err := newEmulator(config.PhylumPath, config.PhylumConfigPath, log, snapshot)
+	return emu, snapshot != nil, err
+}
+
+// autoSnapshot saves the state of e to path every interval until ctx is
+// done.  Failures are logged and retried at the next interval.
+func (e *emulator) autoSnapshot(ctx context.Context, path string, interval time.Duration, log func(context.Context) *logrus.Entry) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := writeSnapshotFile(path, e.snapshot); err != nil {
+				log(ctx).WithError(err).Error("auto snapshot failed")
+			}
+		}
+	}
+}
+
+// stop saves the state of e to path, if it is set, and stops the phylum.
+func (e *emulator) stop(path string) error {
+	var err error
+	if path != "" {
+		err = writeSnapshotFile(path, e.snapshot)
+	}
+	return errors.Join(err, e.close())
+}
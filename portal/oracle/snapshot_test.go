@@ -0,0 +1,82 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	"github.com/luthersystems/svc/oracle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteSnapshotFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.snap")
+
+	f, err := openSnapshotFile(path)
+	require.NoError(t, err)
+	assert.Nil(t, f, "missing snapshot file")
+
+	write := func(data string) func(io.Writer) error {
+		return func(w io.Writer) error {
+			_, err := io.WriteString(w, data)
+			return err
+		}
+	}
+	require.NoError(t, writeSnapshotFile(path, write("first")))
+	require.NoError(t, writeSnapshotFile(path, write("second")))
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(b))
+
+	// A failed snapshot leaves the previous one in place.
+	err = writeSnapshotFile(path, func(w io.Writer) error {
+		_, _ = io.WriteString(w, "partial")
+		return errors.New("boom")
+	})
+	require.Error(t, err)
+	b, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(b))
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "temporary files are removed")
+}
+
+func newTestStandalone(t *testing.T, snapshot []byte) *Standalone {
+	t.Helper()
+	cfg := &Config{Config: *oracle.DefaultConfig()}
+	cfg.PhylumPath = phylumRelPath
+	cfg.Verbose = testing.Verbose()
+	var r io.Reader
+	if snapshot != nil {
+		r = bytes.NewReader(snapshot)
+	}
+	s, err := NewStandalone(context.Background(), cfg, r)
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, s.Close()) })
+	return s
+}
+
+func TestStandaloneSnapshot(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStandalone(t, nil)
+	created, err := s.Client().CreateClaim(ctx, &pb.CreateClaimRequest{})
+	require.NoError(t, err)
+	id := created.GetClaim().GetClaimId()
+	require.NotEmpty(t, id)
+	var snap bytes.Buffer
+	require.NoError(t, s.Snapshot(&snap))
+
+	restored := newTestStandalone(t, snap.Bytes())
+	got, err := restored.Client().GetClaim(ctx, &pb.GetClaimRequest{ClaimId: id})
+	require.NoError(t, err)
+	assert.Equal(t, id, got.GetClaim().GetClaimId())
+}
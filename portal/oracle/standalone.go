@@ -0,0 +1,80 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+
+	srv "github.com/luthersystems/sandbox/api/srvpb/v1"
+	"github.com/luthersystems/svc/oracle"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Standalone is a portal running the phylum in memory within the process,
+// without serving its API over the network.  It is used by tools which build
+// and inspect snapshots of the emulated phylum.
+type Standalone struct {
+	p      *portal
+	server *grpc.Server
+	conn   *grpc.ClientConn
+}
+
+// NewStandalone starts a portal for config, running the phylum at
+// config.PhylumPath in memory.  The phylum state is restored from snapshot
+// if it is not nil.  Calls are not authenticated.
+func NewStandalone(ctx context.Context, config *Config, snapshot io.Reader) (*Standalone, error) {
+	cfg := config.Config
+	// The portal runs the phylum itself.
+	cfg.EmulateCC = false
+	orc, err := oracle.NewOracle(&cfg)
+	if err != nil {
+		return nil, fmt.Errorf("new oracle: %w", err)
+	}
+	emu, err := newEmulator(config.PhylumPath, config.PhylumConfigPath, orc.Log(ctx), snapshot)
+	if err != nil {
+		return nil, err
+	}
+	s := &Standalone{p: &portal{orc: orc, emu: emu, requestIDHeader: cfg.RequestIDHeader, serviceName: cfg.ServiceName, version: cfg.Version, phylumServiceName: cfg.PhylumServiceName}}
+	s.server = s.p.newGRPCServer(ctx)
+	lis := bufconn.Listen(1 << 20)
+	go func() { _ = s.server.Serve(lis) }()
+	s.conn, err = grpc.NewClient("passthrough:///standalone",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		s.server.Stop()
+		return nil, errors.Join(fmt.Errorf("standalone client: %w", err), emu.close())
+	}
+	return s, nil
+}
+
+// Client returns a client calling the portal.
+func (s *Standalone) Client() srv.SandboxServiceClient {
+	return srv.NewSandboxServiceClient(s.conn)
+}
+
+// Snapshot writes the state of the phylum to w.
+func (s *Standalone) Snapshot(w io.Writer) error {
+	return s.p.emu.snapshot(w)
+}
+
+// SaveSnapshot replaces the file at path with a snapshot of the state of the
+// phylum.  The file is replaced only once the snapshot is complete.
+func (s *Standalone) SaveSnapshot(path string) error {
+	return writeSnapshotFile(path, s.Snapshot)
+}
+
+// Close stops the portal and its phylum.
+func (s *Standalone) Close() error {
+	err := s.conn.Close()
+	s.server.GracefulStop()
+	return errors.Join(err, s.p.emu.close())
+}
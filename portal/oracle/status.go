@@ -0,0 +1,93 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+
+	common "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/common/v1"
+	"github.com/luthersystems/svc/svcerr"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// notFoundMetadataKey marks exceptions raised by the phylum for missing
+// resources.  Its value names the kind of resource, e.g. "claim".
+const notFoundMetadataKey = "not_found"
+
+// exceptionCode returns the status code for ex if it reports a missing
+// resource or a revision conflict, which the gateway renders as HTTP 404 and
+// 409.  svcerr maps exceptions to codes by type alone, reporting both as
+// business exceptions with HTTP 400.
+func exceptionCode(ex *common.Exception) (codes.Code, bool) {
+	md := ex.GetExceptionMetadata()
+	switch {
+	case md[conflictMetadataKey] != "":
+		return codes.Aborted, true
+	case md[notFoundMetadataKey] != "":
+		return codes.NotFound, true
+	}
+	return codes.OK, false
+}
+
+// exceptionError returns the status error a caller receives for a response
+// carrying ex.  It is the error svcerr returns, except for missing resources
+// and revision conflicts, which have the code given by exceptionCode and ex
+// as their only detail.
+func exceptionError(ctx context.Context, ex *common.Exception) error {
+	if code, ok := exceptionCode(ex); ok {
+		stat, err := status.New(code, ex.GetDescription()).WithDetails(ex)
+		if err != nil {
+			return status.Error(code, ex.GetDescription())
+		}
+		return stat.Err()
+	}
+	intercept := svcerr.AppErrorUnaryInterceptor(logrus.WithContext)
+	_, err := intercept(ctx, nil, nil, func(context.Context, any) (any, error) {
+		return &common.ExceptionResponse{Exception: ex}, nil
+	})
+	return err
+}
+
+// errorException returns the exception carried by a status error, either as
+// its detail or within the response svcerr attaches to business exceptions.
+func errorException(err error) *common.Exception {
+	for _, d := range status.Convert(err).Details() {
+		switch d := d.(type) {
+		case *common.Exception:
+			return d
+		case interface{ GetException() *common.Exception }:
+			return d.GetException()
+		}
+	}
+	return nil
+}
+
+// exceptionInterceptor returns exceptions reporting missing resources and
+// revision conflicts as status errors with the codes given by
+// exceptionCode, so that gateway clients receive HTTP 404 and 409 and gRPC
+// clients the matching status code.  The body of the HTTP response keeps its
+// {"exception": ...} schema.  Other exceptions are left in the response for
+// svcerr to map by type.
+func exceptionInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+		msg, ok := resp.(proto.Message)
+		if !ok {
+			return resp, nil
+		}
+		ex := responseException(msg)
+		if _, ok := exceptionCode(ex); !ok {
+			return resp, nil
+		}
+		// svcerr expects an empty response of the method's type alongside
+		// the error.
+		return msg.ProtoReflect().Type().New().Interface(), exceptionError(ctx, ex)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"testing"
+
+	common "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/common/v1"
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	srv "github.com/luthersystems/sandbox/api/srvpb/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestExceptionError(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []struct {
+		ex   *common.Exception
+		code codes.Code
+	}{
+		// Missing resources and conflicts have their own codes.
+		{&common.Exception{Type: common.Exception_BUSINESS, ExceptionMetadata: map[string]string{notFoundMetadataKey: "claim"}}, codes.NotFound},
+		{&common.Exception{Type: common.Exception_BUSINESS, ExceptionMetadata: map[string]string{conflictMetadataKey: "revision"}}, codes.Aborted},
+		// Other exceptions have the codes svcerr gives their type.
+		{&common.Exception{Type: common.Exception_BUSINESS}, codes.InvalidArgument},
+		{&common.Exception{Type: common.Exception_SERVICE_NOT_AVAILABLE}, codes.Unavailable},
+		{&common.Exception{Type: common.Exception_SECURITY_VIOLATION}, codes.PermissionDenied},
+	} {
+		err := exceptionError(ctx, tc.ex)
+		assert.Equal(t, tc.code, status.Code(err), "%v", tc.ex)
+		assert.True(t, proto.Equal(tc.ex, errorException(err)), "the exception is carried by the error: %v", tc.ex)
+	}
+}
+
+func TestExceptionInterceptor(t *testing.T) {
+	ctx := context.Background()
+	info := &grpc.UnaryServerInfo{FullMethod: srv.SandboxService_GetClaim_FullMethodName}
+	intercept := exceptionInterceptor()
+	respond := func(resp any, err error) grpc.UnaryHandler {
+		return func(context.Context, any) (any, error) { return resp, err }
+	}
+
+	ok := &pb.GetClaimResponse{Result: &pb.GetClaimResponse_Claim{Claim: &pb.Claim{ClaimId: "a"}}}
+	resp, err := intercept(ctx, nil, info, respond(ok, nil))
+	require.NoError(t, err)
+	assert.Same(t, ok, resp)
+
+	missing := &common.Exception{
+		Type:              common.Exception_BUSINESS,
+		Description:       "missing claim b",
+		ExceptionMetadata: map[string]string{notFoundMetadataKey: "claim"},
+	}
+	resp, err = intercept(ctx, nil, info, respond(&pb.GetClaimResponse{
+		Result: &pb.GetClaimResponse_Exception{Exception: missing},
+	}, nil))
+	stat, isStatus := status.FromError(err)
+	require.True(t, isStatus)
+	assert.Equal(t, codes.NotFound, stat.Code())
+	assert.Equal(t, "missing claim b", stat.Message())
+	require.Len(t, stat.Details(), 1)
+	assert.True(t, proto.Equal(missing, stat.Details()[0].(proto.Message)), "the exception is the detail")
+	require.IsType(t, &pb.GetClaimResponse{}, resp, "svcerr needs a typed response")
+	assert.Nil(t, resp.(*pb.GetClaimResponse).GetException())
+
+	// Other exceptions are left for svcerr.
+	invalid := &pb.GetClaimResponse{Result: &pb.GetClaimResponse_Exception{Exception: &common.Exception{
+		Type:        common.Exception_BUSINESS,
+		Description: "invalid claim",
+	}}}
+	resp, err = intercept(ctx, nil, info, respond(invalid, nil))
+	require.NoError(t, err)
+	assert.Same(t, invalid, resp)
+
+	failed := status.Error(codes.Unauthenticated, "no")
+	_, err = intercept(ctx, nil, info, respond(nil, failed))
+	assert.Same(t, failed, err)
+}
@@ -0,0 +1,55 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	common "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/common/v1"
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/luthersystems/svc/svcerr"
+	"google.golang.org/protobuf/proto"
+)
+
+// requestValidator enforces the buf.validate rules declared on the request
+// messages in api/pb.
+var requestValidator = sync.OnceValues(func() (protovalidate.Validator, error) {
+	return protovalidate.New()
+})
+
+// validateRequest checks req against its declared constraints.  Violations
+// are returned as a business exception whose metadata maps each offending
+// field path to a description of the problem.  An error is returned only if
+// the constraints themselves could not be evaluated.
+func validateRequest(ctx context.Context, req proto.Message) (*common.Exception, error) {
+	v, err := requestValidator()
+	if err != nil {
+		return nil, fmt.Errorf("request validator: %w", err)
+	}
+	err = v.Validate(req)
+	if err == nil {
+		return nil, nil
+	}
+	var verr *protovalidate.ValidationError
+	if !errors.As(err, &verr) {
+		return nil, fmt.Errorf("validate %s: %w", req.ProtoReflect().Descriptor().FullName(), err)
+	}
+	meta := make(map[string]string, len(verr.Violations))
+	var msgs []string
+	for _, viol := range verr.Violations {
+		path := protovalidate.FieldPathString(viol.Proto.GetField())
+		msg := viol.Proto.GetMessage()
+		msgs = append(msgs, fmt.Sprintf("%s: %s", path, msg))
+		if prev, ok := meta[path]; ok {
+			msg = prev + "; " + msg
+		}
+		meta[path] = msg
+	}
+	ex := svcerr.BusinessException(ctx, "invalid request: "+strings.Join(msgs, ", "))
+	ex.ExceptionMetadata = meta
+	return ex, nil
+}
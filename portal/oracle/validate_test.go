@@ -0,0 +1,117 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+
+package oracle
+
+import (
+	"context"
+	"testing"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	common "buf.build/gen/go/luthersystems/protos/protocolbuffers/go/common/v1"
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const testClaimID = "0b9d6e5c-4f1a-4d2e-9c3b-7a8f6e5d4c3b"
+
+// maxClaimReasonLength is the max_len rule on the claim_reason of
+// CreateClaimRequest.
+var maxClaimReasonLength = func() int {
+	fd := (&pb.CreateClaimRequest{}).ProtoReflect().Descriptor().Fields().ByName("claim_reason")
+	rules := proto.GetExtension(fd.Options(), validate.E_Field).(*validate.FieldConstraints)
+	return int(rules.GetString_().GetMaxLen())
+}()
+
+// requireInvalid checks that err rejects an invalid request with an
+// InvalidArgument status carrying a business exception, which the gateway
+// renders as a 400.
+func requireInvalid(t *testing.T, err error) *common.Exception {
+	t.Helper()
+	stat, ok := status.FromError(err)
+	require.True(t, ok, "status error: %v", err)
+	require.Equal(t, codes.InvalidArgument, stat.Code())
+	ex := errorException(err)
+	require.NotNil(t, ex, "exception detail")
+	assert.Equal(t, common.Exception_BUSINESS, ex.GetType())
+	return ex
+}
+
+func validClaimant() *pb.Claimant {
+	return &pb.Claimant{
+		AccountNumber:   "12345678",
+		AccountSortCode: "12-34-56",
+		Dob:             "1980-01-31",
+		Surname:         "Doe",
+		Forename:        "Jane",
+		Nationality:     pb.Nationality_NATIONALITY_GB,
+	}
+}
+
+func TestValidateRequest(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    proto.Message
+		fields []string
+	}{
+		"valid add claimant": {
+			req: &pb.AddClaimantRequest{ClaimId: testClaimID, Claimant: validClaimant()},
+		},
+		"missing claimant": {
+			req:    &pb.AddClaimantRequest{ClaimId: testClaimID},
+			fields: []string{"claimant"},
+		},
+		"bad claim id": {
+			req:    &pb.AddClaimantRequest{ClaimId: "xyz", Claimant: validClaimant()},
+			fields: []string{"claim_id"},
+		},
+		"bad claimant": {
+			req: &pb.AddClaimantRequest{ClaimId: testClaimID, Claimant: &pb.Claimant{
+				AccountNumber: "12ab",
+				Dob:           "31/01/1980",
+				Forename:      "Jane",
+				Nationality:   pb.Nationality(99),
+			}},
+			fields: []string{
+				"claimant.account_number",
+				"claimant.dob",
+				"claimant.nationality",
+			},
+		},
+		"empty create claim": {
+			req: &pb.CreateClaimRequest{},
+		},
+		"negative damage amount": {
+			req:    &pb.CreateClaimRequest{DamageAmount: -1},
+			fields: []string{"damage_amount"},
+		},
+		"bad page token": {
+			req:    &pb.ListClaimsRequest{PageToken: "not-a-token", PageSize: -1},
+			fields: []string{"page_token", "page_size"},
+		},
+		"bad list filters": {
+			req: &pb.ListClaimsRequest{
+				States:           []pb.ClaimState{pb.ClaimState(99)},
+				DateOfAccidentTo: "yesterday",
+			},
+			fields: []string{"states[0]", "date_of_accident_to"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ex, err := validateRequest(context.Background(), tc.req)
+			require.NoError(t, err)
+			if len(tc.fields) == 0 {
+				assert.Nil(t, ex)
+				return
+			}
+			require.NotNil(t, ex)
+			assert.Equal(t, common.Exception_BUSINESS, ex.GetType())
+			for _, f := range tc.fields {
+				assert.Contains(t, ex.GetExceptionMetadata(), f)
+			}
+			assert.Len(t, ex.GetExceptionMetadata(), len(tc.fields))
+		})
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	srv "github.com/luthersystems/sandbox/api/srvpb/v1"
+	"github.com/luthersystems/sandbox/portal/oracle"
+	"google.golang.org/grpc/metadata"
+)
+
+// snapshotCmd builds and inspects snapshots of the in-memory phylum, which
+// the portal restores with --snapshot-file, and takes and restores snapshots
+// of a running portal in in-memory mode.
+type snapshotCmd struct {
+	Save    snapshotSaveCmd    `cmd:"save" help:"Save a snapshot, optionally importing claims into it"`
+	Load    snapshotLoadCmd    `cmd:"load" help:"Load a snapshot and export the claims it holds"`
+	Take    snapshotTakeCmd    `cmd:"take" help:"Take a snapshot of a running portal"`
+	Restore snapshotRestoreCmd `cmd:"restore" help:"Restore a snapshot into a running portal"`
+}
+
+// snapshotFlags configure the in-memory phylum of snapshot commands.
+type snapshotFlags struct {
+	PhylumPath string `short:"p" help:"Phylum path for in-memory mode" default:"./phylum" env:"SANDBOX_ORACLE_PHYLUM_PATH"`
+}
+
+// start runs the portal in memory, restoring the phylum from the snapshot
+// file if it is set.
+func (f *snapshotFlags) start(ctx context.Context, file string) (*oracle.Standalone, error) {
+	var snapshot io.Reader
+	if file != "" {
+		in, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		defer in.Close()
+		snapshot = in
+	}
+	cfg := newOracleConfig(f.PhylumPath)
+	return oracle.NewStandalone(ctx, &oracle.Config{Config: *cfg}, snapshot)
+}
+
+type snapshotSaveCmd struct {
+	baseCmd
+	snapshotFlags
+	Output      string `arg:"" help:"File to write the snapshot to" type:"path"`
+	From        string `help:"Snapshot to start from (starts from a new phylum if not set)" type:"existingfile"`
+	Import      string `help:"CSV or NDJSON file of claims to import before saving (- for stdin)"`
+	Format      string `help:"Format of the import file (auto detects from the file extension)" enum:"auto,csv,ndjson" default:"auto"`
+	Concurrency int    `help:"Maximum number of claims imported at once" default:"8"`
+}
+
+func (r *snapshotSaveCmd) Run() error {
+	portal, err := r.start(r.ctx, r.From)
+	if err != nil {
+		return err
+	}
+	defer portal.Close()
+	if r.Import != "" {
+		read, closeFile, err := openImportFile(r.Import, r.Format)
+		if err != nil {
+			return err
+		}
+		defer closeFile()
+		if err := importClaims(r.ctx, portal.Client(), read, r.Concurrency, os.Stdout); err != nil {
+			return err
+		}
+	}
+	return portal.SaveSnapshot(r.Output)
+}
+
+type snapshotLoadCmd struct {
+	baseCmd
+	snapshotFlags
+	File   string `arg:"" help:"Snapshot to load" type:"existingfile"`
+	Format string `help:"Format of the export" enum:"ndjson,csv,parquet" default:"ndjson"`
+	Output string `short:"o" help:"File to write the export to (- for stdout)" default:"-"`
+}
+
+func (r *snapshotLoadCmd) Run() (err error) {
+	portal, err := r.start(r.ctx, r.File)
+	if err != nil {
+		return err
+	}
+	defer portal.Close()
+	out := os.Stdout
+	if r.Output != "-" {
+		f, err := os.Create(r.Output)
+		if err != nil {
+			return err
+		}
+		defer func() { err = errors.Join(err, f.Close()) }()
+		out = f
+	}
+	w, err := newClaimWriter(r.Format, out)
+	if err != nil {
+		return err
+	}
+	n, err := exportClaims(r.ctx, portal.Client(), &pb.ExportClaimsRequest{}, w)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "loaded %d claims from %s\n", n, r.File)
+	return nil
+}
+
+// adminFlags configure commands which call a running portal's admin API.
+type adminFlags struct {
+	connFlags
+	AdminKey string `help:"Key authorizing the admin API" env:"SANDBOX_PORTAL_ADMIN_KEY"`
+}
+
+// dialAdmin connects to the portal's admin API.  The returned context carries
+// the admin key, and the returned function closes the connection.
+func (f *adminFlags) dialAdmin(ctx context.Context) (context.Context, srv.AdminServiceClient, func() error, error) {
+	conn, err := f.dialConn()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, oracle.AdminKeyHeader, f.AdminKey)
+	return ctx, srv.NewAdminServiceClient(conn), conn.Close, nil
+}
+
+type snapshotTakeCmd struct {
+	baseCmd
+	adminFlags
+	Output string `arg:"" help:"File to write the snapshot to" type:"path"`
+}
+
+func (r *snapshotTakeCmd) Run() (err error) {
+	ctx, client, closeConn, err := r.dialAdmin(r.ctx)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+	stream, err := client.TakeSnapshot(ctx, &pb.TakeSnapshotRequest{})
+	if err != nil {
+		return err
+	}
+	out, err := os.Create(r.Output)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, out.Close())
+		if err != nil {
+			os.Remove(r.Output)
+		}
+	}()
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := out.Write(resp.GetData()); err != nil {
+			return err
+		}
+	}
+}
+
+type snapshotRestoreCmd struct {
+	baseCmd
+	adminFlags
+	File string `arg:"" help:"Snapshot to restore" type:"existingfile"`
+}
+
+func (r *snapshotRestoreCmd) Run() error {
+	in, err := os.Open(r.File)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	ctx, client, closeConn, err := r.dialAdmin(r.ctx)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+	stream, err := client.RestoreSnapshot(ctx)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, 64<<10)
+	for {
+		n, err := in.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&pb.RestoreSnapshotRequest{Data: buf[:n]}); err != nil {
+				// The cause is returned by CloseAndRecv.
+				break
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		if ex := exceptionFromError(err); ex != nil {
+			return fmt.Errorf("restore: %s", ex.GetDescription())
+		}
+		return err
+	}
+	if ex := resp.GetException(); ex != nil {
+		return fmt.Errorf("restore: %s", ex.GetDescription())
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright © 2025 Luther Systems, Ltd. All right reserved.
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	pb "github.com/luthersystems/sandbox/api/pb/v1"
+	"github.com/luthersystems/sandbox/portal/version"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestSnapshotSaveLoad(t *testing.T) {
+	// The version is set by the build, and the oracle requires one.
+	old := version.Version
+	version.Version = "test"
+	t.Cleanup(func() { version.Version = old })
+	ctx := context.Background()
+	dir := t.TempDir()
+	claims := filepath.Join(dir, "claims.csv")
+	require.NoError(t, os.WriteFile(claims, []byte("claim_reason,forename,surname\n"+
+		"Rear-ended,Raymond,Smith\n"+
+		"Hail,,\n"), 0o600))
+	flags := snapshotFlags{PhylumPath: "../phylum"}
+
+	snap := filepath.Join(dir, "demo.snap")
+	save := &snapshotSaveCmd{
+		baseCmd:       baseCmd{ctx: ctx},
+		snapshotFlags: flags,
+		Output:        snap,
+		Import:        claims,
+		Format:        "auto",
+		Concurrency:   2,
+	}
+	require.NoError(t, save.Run())
+
+	out := filepath.Join(dir, "claims.ndjson")
+	load := &snapshotLoadCmd{
+		baseCmd:       baseCmd{ctx: ctx},
+		snapshotFlags: flags,
+		File:          snap,
+		Format:        "ndjson",
+		Output:        out,
+	}
+	require.NoError(t, load.Run())
+	b, err := os.ReadFile(out)
+	require.NoError(t, err)
+	var reasons []string
+	for _, line := range strings.Split(strings.TrimSuffix(string(b), "\n"), "\n") {
+		claim := &pb.Claim{}
+		require.NoError(t, protojson.Unmarshal([]byte(line), claim))
+		reasons = append(reasons, claim.GetClaimReason())
+	}
+	assert.ElementsMatch(t, []string{"Rear-ended", "Hail"}, reasons, "the loaded snapshot holds the imported claims")
+}
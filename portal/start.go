@@ -2,28 +2,62 @@
 package main
 
 import (
+	"time"
+
 	"github.com/luthersystems/sandbox/api"
 	"github.com/luthersystems/sandbox/portal/oracle"
 	"github.com/luthersystems/sandbox/portal/version"
 	svc "github.com/luthersystems/svc/oracle"
 )
 
+// startFlags configure the portal.  Each option is set, in order of
+// precedence, by its flag, its environment variable, the --config file or
+// its default.
+type startFlags struct {
+	Config                string            `help:"YAML or TOML file of start options, keyed by flag name" type:"existingfile" env:"SANDBOX_ORACLE_CONFIG"`
+	ListenAddress         string            `short:"l" help:"Address to listen on" default:":8080" env:"SANDBOX_ORACLE_LISTEN_ADDRESS"`
+	GatewayEndpoint       string            `short:"g" help:"URL for shiroclient gateway" env:"SANDBOX_ORACLE_GATEWAY_ENDPOINT"`
+	OTLPEndpoint          string            `short:"o" help:"URL for OTLP provider" env:"SANDBOX_ORACLE_OTLP_ENDPOINT"`
+	PhylumPath            string            `short:"p" help:"Phylum path for in-memory mode" default:"./phylum" env:"SANDBOX_ORACLE_PHYLUM_PATH"`
+	Verbose               bool              `short:"v" help:"Verbose logging" default:"false" env:"SANDBOX_ORACLE_VERBOSE"`
+	EmulateCC             bool              `short:"e" help:"Enable in-memory-mode" default:"false" env:"SANDBOX_ORACLE_EMULATE_CC"`
+	APIKeyFile            string            `help:"File of accepted API keys, one name=key per line" type:"path" env:"SANDBOX_ORACLE_API_KEY_FILE"`
+	APIKeys               map[string]string `help:"Accepted API keys, as name=key pairs" env:"SANDBOX_ORACLE_API_KEYS" redact:""`
+	JWKS                  string            `help:"Path or URL of the JWKS used to verify bearer tokens" env:"SANDBOX_ORACLE_JWKS"`
+	JWTIssuer             string            `help:"Required issuer of bearer tokens" env:"SANDBOX_ORACLE_JWT_ISSUER"`
+	JWTAudience           string            `help:"Required audience of bearer tokens" env:"SANDBOX_ORACLE_JWT_AUDIENCE"`
+	JWTClockSkew          time.Duration     `help:"Clock skew allowed when checking bearer token lifetimes" default:"1m" env:"SANDBOX_ORACLE_JWT_CLOCK_SKEW"`
+	JWTRolesClaim         string            `help:"Bearer token claim listing the caller's roles" default:"roles" env:"SANDBOX_ORACLE_JWT_ROLES_CLAIM"`
+	PolicyFile            string            `help:"YAML file granting API methods to caller roles" type:"path" env:"SANDBOX_ORACLE_POLICY_FILE"`
+	IdempotencyTTL        time.Duration     `help:"How long responses are remembered by idempotency key" default:"24h" env:"SANDBOX_ORACLE_IDEMPOTENCY_TTL"`
+	IdempotencyMaxEntries int               `help:"How many responses are remembered by idempotency key" default:"100000" env:"SANDBOX_ORACLE_IDEMPOTENCY_MAX_ENTRIES"`
+	GRPCListenAddress     string            `help:"Address to serve the gRPC API on (disabled if empty)" env:"SANDBOX_ORACLE_GRPC_LISTEN_ADDRESS"`
+	SnapshotFile          string            `help:"File the in-memory state is restored from at startup and saved to at shutdown (requires --emulate-cc)" type:"path" env:"SANDBOX_ORACLE_SNAPSHOT_FILE"`
+	SnapshotInterval      time.Duration     `help:"How often the in-memory state is also saved to the snapshot file (disabled if zero)" default:"0s" env:"SANDBOX_ORACLE_SNAPSHOT_INTERVAL"`
+	AdminKey              string            `help:"Key authorizing the admin API in in-memory mode (disabled if empty)" env:"SANDBOX_ORACLE_ADMIN_KEY" redact:""`
+	Seed                  string            `help:"YAML or JSON fixture of claims to create at startup (requires --emulate-cc)" type:"existingfile" env:"SANDBOX_ORACLE_SEED"`
+	DrainTimeout          time.Duration     `help:"How long to wait on SIGTERM or interrupt for calls in progress to complete before stopping" default:"30s" env:"SANDBOX_ORACLE_DRAIN_TIMEOUT"`
+	MetricsListenAddress  string            `help:"Address to also serve /metrics on, apart from the API (metrics are always served on :9600)" env:"SANDBOX_ORACLE_METRICS_LISTEN_ADDRESS"`
+}
+
 type startCmd struct {
 	baseCmd
-	ListenAddress   string `short:"l" help:"Address to listen on" default:":8080" env:"SANDBOX_ORACLE_LISTEN_ADDRESS"`
-	GatewayEndpoint string `short:"g" help:"URL for shiroclient gateway" env:"SANDBOX_ORACLE_GATEWAY_ENDPOINT"`
-	OTLPEndpoint    string `short:"o" help:"URL for OTLP provider" env:"SANDBOX_ORACLE_OTLP_ENDPOINT"`
-	PhylumPath      string `short:"p" help:"Phylum path for in-memory mode" default:"./phylum" env:"SANDBOX_ORACLE_PHYLUM_PATH"`
-	Verbose         bool   `short:"v" help:"Verbose logging" default:"false" env:"SANDBOX_ORACLE_VERBOSE"`
-	EmulateCC       bool   `short:"e" help:"Enable in-memory-mode" default:"false" env:"SANDBOX_ORACLE_EMULATE_CC"`
+	startFlags
 }
 
-func (r *startCmd) Run() error {
+// newOracleConfig returns the oracle configuration shared by the commands
+// which run the portal.
+func newOracleConfig(phylumPath string) *svc.Config {
 	cfg := svc.DefaultConfig()
 	cfg.PhylumServiceName = "sandbox"
 	cfg.ServiceName = "sandbox-oracle"
 	cfg.Version = version.Version
-	cfg.PhylumPath = r.PhylumPath
+	cfg.PhylumPath = phylumPath
+	return cfg
+}
+
+func (r *startCmd) Run() error {
+	cfg := newOracleConfig(r.PhylumPath)
 	cfg.SetOTLPEndpoint(r.OTLPEndpoint)
 	cfg.SetSwaggerHandler(api.SwaggerHandlerOrPanic("v1/oracle"))
 	cfg.ListenAddress = r.ListenAddress
@@ -32,6 +66,26 @@ func (r *startCmd) Run() error {
 	cfg.EmulateCC = r.EmulateCC
 
 	return oracle.Run(r.ctx, &oracle.Config{
-		Config: *cfg,
+		Config:     *cfg,
+		APIKeyFile: r.APIKeyFile,
+		APIKeys:    r.APIKeys,
+		JWT: oracle.JWTConfig{
+			JWKS:       r.JWKS,
+			Issuer:     r.JWTIssuer,
+			Audience:   r.JWTAudience,
+			ClockSkew:  r.JWTClockSkew,
+			RolesClaim: r.JWTRolesClaim,
+		},
+		PolicyFile:            r.PolicyFile,
+		IdempotencyTTL:        r.IdempotencyTTL,
+		IdempotencyMaxEntries: r.IdempotencyMaxEntries,
+		GRPCListenAddress:     r.GRPCListenAddress,
+		SnapshotFile:          r.SnapshotFile,
+		SnapshotInterval:      r.SnapshotInterval,
+		AdminKey:              r.AdminKey,
+		SeedFile:              r.Seed,
+		DrainTimeout:          r.DrainTimeout,
+		OTLPEndpoint:          r.OTLPEndpoint,
+		MetricsListenAddress:  r.MetricsListenAddress,
 	})
 }